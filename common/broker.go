@@ -0,0 +1,82 @@
+package common
+
+import "sync"
+
+// Broker deliver cluster messages, default redis pub/sub
+type Broker interface {
+	Publish(channel, message string) error
+	Subscribe(handler func(channel string, data []byte), channels ...string) error
+}
+
+var defaultBroker Broker
+var defaultBrokerMu sync.Mutex
+
+func GetBroker() Broker {
+	defaultBrokerMu.Lock()
+	defer defaultBrokerMu.Unlock()
+
+	if defaultBroker == nil {
+		defaultBroker = GetRedis()
+	}
+	return defaultBroker
+}
+
+// Set broker at startup, before any publish or subscribe
+func SetBroker(broker Broker) {
+	defaultBrokerMu.Lock()
+	defer defaultBrokerMu.Unlock()
+
+	defaultBroker = broker
+}
+
+type memoryMessage struct {
+	channel string
+	data    []byte
+}
+
+type memorySubscriber struct {
+	channels map[string]bool
+	messages chan *memoryMessage
+}
+
+// Broker in process, only for single process test
+type MemoryBroker struct {
+	mu          sync.Mutex
+	subscribers []*memorySubscriber
+}
+
+func NewMemoryBroker() *MemoryBroker {
+	return &MemoryBroker{}
+}
+
+func (b *MemoryBroker) Publish(channel, message string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, sub := range b.subscribers {
+		if sub.channels[channel] {
+			sub.messages <- &memoryMessage{channel: channel, data: []byte(message)}
+		}
+	}
+	return nil
+}
+
+// Block forever, same as redis conn never broken
+func (b *MemoryBroker) Subscribe(handler func(channel string, data []byte), channels ...string) error {
+	sub := &memorySubscriber{
+		channels: make(map[string]bool),
+		messages: make(chan *memoryMessage, 256),
+	}
+	for _, channel := range channels {
+		sub.channels[channel] = true
+	}
+
+	b.mu.Lock()
+	b.subscribers = append(b.subscribers, sub)
+	b.mu.Unlock()
+
+	for msg := range sub.messages {
+		handler(msg.channel, msg.data)
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package common
+
+import "encoding/json"
+
+// Redis pub/sub channels subscribed by every gateway
+const (
+	BroadcastChannel = "gateway:broadcast"
+	PushChannel      = "gateway:push"
+	KickChannel      = "gateway:kick"
+	ChatChannel      = "gateway:chat"
+)
+
+// Message between gateways and services, data is serialized pb.Message
+type ClusterMessage struct {
+	Node   string `json:"node,omitempty"`
+	Uid    string `json:"uid,omitempty"`
+	Reason string `json:"reason,omitempty"`
+	Chat   string `json:"chat,omitempty"` // chat channel name
+	Data   []byte `json:"data,omitempty"`
+}
+
+func PublishCluster(channel string, msg *ClusterMessage) error {
+	content, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return GetBroker().Publish(channel, string(content))
+}
@@ -0,0 +1,296 @@
+package common
+
+import (
+	"flag"
+	"io/ioutil"
+	"log"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Config load order: default, yaml file, env, flags. later override former
+
+var defaultConfig *Config
+var defaultConfigOnce sync.Once
+
+var configFile = flag.String("config", "config.yml", "config file path, env GAME_CONFIG")
+
+// Flags override all, empty as not set
+var (
+	storageFlag     = flag.String("storage", "", "storage backend, mongo or memory")
+	gatewayAddrFlag = flag.String("gateway.addr", "", "gateway listen address")
+	serviceAddrFlag = flag.String("service.addr", "", "game service listen and dial address")
+	mongoAddrFlag   = flag.String("mongo.addr", "", "mongo server address")
+	mongoDbFlag     = flag.String("mongo.database", "", "mongo database name")
+	redisAddrFlag   = flag.String("redis.addr", "", "redis server address")
+)
+
+type Config struct {
+	Storage string        `yaml:"storage"` // mongo or memory, memory only for test
+	Gateway GatewayConfig `yaml:"gateway"`
+	Service ServiceConfig `yaml:"service"`
+	Mongo   MongoConfig   `yaml:"mongo"`
+	Redis   RedisConfig   `yaml:"redis"`
+
+	Leaderboard LeaderboardConfig `yaml:"leaderboard"`
+	Matchmaking MatchmakingConfig `yaml:"matchmaking"`
+}
+
+type GatewayConfig struct {
+	Addr            string                     `yaml:"addr"`
+	WriteWait       time.Duration              `yaml:"write_wait"`
+	PongWait        time.Duration              `yaml:"pong_wait"` // ping period is 9/10 of it
+	MaxMessageSize  int64                      `yaml:"max_message_size"`
+	RateLimit       RateLimitConfig            `yaml:"rate_limit"`       // per client, per message type
+	RateLimits      map[string]RateLimitConfig `yaml:"rate_limits"`      // by message name, eg: ChatNotify
+	AbuseRateLimit  RateLimitConfig            `yaml:"abuse_rate_limit"` // rejected messages before kick
+	FlushInterval   time.Duration              `yaml:"flush_interval"`   // dirty redis users to mgo
+	ShutdownTimeout time.Duration              `yaml:"shutdown_timeout"` // exit anyway after it
+	RoomTick        time.Duration              `yaml:"room_tick"`        // game room tick interval
+	RoomMaxPlayers  int                        `yaml:"room_max_players"`
+	ResumeGrace     time.Duration              `yaml:"resume_grace"`     // keep dropped client for reconnect
+	ResumeBuffer    int                        `yaml:"resume_buffer"`    // recent pushes kept for replay
+	AckTimeout      time.Duration              `yaml:"ack_timeout"`      // redeliver critical push not acked
+	RequestTimeout  time.Duration              `yaml:"request_timeout"`  // call to service, default of methods
+	RequestTimeouts map[string]time.Duration   `yaml:"request_timeouts"` // by service method name, eg: GetUserInfo
+}
+
+type RateLimitConfig struct {
+	Rate  float64 `yaml:"rate"` // token per second
+	Burst int     `yaml:"burst"`
+}
+
+type ServiceConfig struct {
+	Addr            string        `yaml:"addr"`
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"` // force stop after it
+}
+
+type MongoConfig struct {
+	Addr       string        `yaml:"addr"`
+	Database   string        `yaml:"database"`
+	ChatExpire time.Duration `yaml:"chat_expire"` // chat history removed after it, 0 as never
+}
+
+type RedisConfig struct {
+	Addr        string        `yaml:"addr"`
+	MaxIdle     int           `yaml:"max_idle"`
+	IdleTimeout time.Duration `yaml:"idle_timeout"`
+}
+
+type LeaderboardConfig struct {
+	Boards         []BoardConfig `yaml:"boards"`
+	ArchiveSize    int           `yaml:"archive_size"`    // top n saved to mgo after period
+	SubmitInterval time.Duration `yaml:"submit_interval"` // per user per board, 0 as no limit
+}
+
+type BoardConfig struct {
+	Name     string `yaml:"name"`
+	Period   string `yaml:"period"`    // daily, weekly or empty as never reset
+	MaxScore int64  `yaml:"max_score"` // greater score is rejected, 0 as no limit
+}
+
+// Rating tolerance widen from base by waited seconds, up to max
+type MatchmakingConfig struct {
+	Regions       []string      `yaml:"regions"`
+	MaxPartySize  int           `yaml:"max_party_size"` // players of one match
+	Interval      time.Duration `yaml:"interval"`       // queue check period
+	BaseTolerance int           `yaml:"base_tolerance"` // rating diff at enqueue
+	WidenRate     int           `yaml:"widen_rate"`     // tolerance added per second
+	MaxTolerance  int           `yaml:"max_tolerance"`
+}
+
+func GetConfig() *Config {
+	defaultConfigOnce.Do(func() {
+		defaultConfig = loadConfig()
+	})
+	return defaultConfig
+}
+
+func newConfig() *Config {
+	return &Config{
+		Storage: "mongo",
+		Gateway: GatewayConfig{
+			Addr:            ":8080",
+			WriteWait:       10 * time.Second,
+			PongWait:        60 * time.Second,
+			MaxMessageSize:  512,
+			RateLimit:       RateLimitConfig{Rate: 5, Burst: 10},
+			AbuseRateLimit:  RateLimitConfig{Rate: 1, Burst: 10},
+			FlushInterval:   30 * time.Second,
+			ShutdownTimeout: 30 * time.Second,
+			RoomTick:        50 * time.Millisecond,
+			RoomMaxPlayers:  8,
+			ResumeGrace:     30 * time.Second,
+			ResumeBuffer:    256,
+			AckTimeout:      10 * time.Second,
+			RequestTimeout:  5 * time.Second,
+		},
+		Service: ServiceConfig{
+			Addr:            ":1234",
+			ShutdownTimeout: 10 * time.Second,
+		},
+		Mongo: MongoConfig{
+			Addr:       ":27017",
+			Database:   "test",
+			ChatExpire: 30 * 24 * time.Hour,
+		},
+		Redis: RedisConfig{
+			Addr:        ":6379",
+			MaxIdle:     3,
+			IdleTimeout: 240 * time.Second,
+		},
+		Leaderboard: LeaderboardConfig{
+			Boards: []BoardConfig{
+				{Name: "score", MaxScore: 1000000},
+				{Name: "score_daily", Period: "daily", MaxScore: 1000000},
+				{Name: "score_weekly", Period: "weekly", MaxScore: 1000000},
+			},
+			ArchiveSize:    100,
+			SubmitInterval: 5 * time.Second,
+		},
+		Matchmaking: MatchmakingConfig{
+			Regions:       []string{"asia", "eu", "na"},
+			MaxPartySize:  10,
+			Interval:      time.Second,
+			BaseTolerance: 50,
+			WidenRate:     10,
+			MaxTolerance:  500,
+		},
+	}
+}
+
+func loadConfig() *Config {
+	if !flag.Parsed() {
+		flag.Parse()
+	}
+
+	cfg := newConfig()
+
+	file := *configFile
+	if env := os.Getenv("GAME_CONFIG"); env != "" && !isFlagSet("config") {
+		file = env
+	}
+	content, err := ioutil.ReadFile(file)
+	if err == nil {
+		if err := yaml.Unmarshal(content, cfg); err != nil {
+			log.Fatalln("config file invalid, err:", err)
+		}
+	} else if !os.IsNotExist(err) || isFlagSet("config") {
+		log.Fatalln("read config file failed, err:", err)
+	}
+
+	overrideString(&cfg.Storage, os.Getenv("GAME_STORAGE"))
+	overrideString(&cfg.Gateway.Addr, os.Getenv("GAME_GATEWAY_ADDR"))
+	overrideString(&cfg.Service.Addr, os.Getenv("GAME_SERVICE_ADDR"))
+	overrideString(&cfg.Mongo.Addr, os.Getenv("GAME_MONGO_ADDR"))
+	overrideString(&cfg.Mongo.Database, os.Getenv("GAME_MONGO_DATABASE"))
+	overrideString(&cfg.Redis.Addr, os.Getenv("GAME_REDIS_ADDR"))
+	overrideDuration(&cfg.Gateway.WriteWait, os.Getenv("GAME_GATEWAY_WRITE_WAIT"))
+	overrideDuration(&cfg.Gateway.PongWait, os.Getenv("GAME_GATEWAY_PONG_WAIT"))
+	overrideInt64(&cfg.Gateway.MaxMessageSize, os.Getenv("GAME_GATEWAY_MAX_MESSAGE_SIZE"))
+	overrideDuration(&cfg.Gateway.FlushInterval, os.Getenv("GAME_GATEWAY_FLUSH_INTERVAL"))
+	overrideDuration(&cfg.Gateway.ShutdownTimeout, os.Getenv("GAME_GATEWAY_SHUTDOWN_TIMEOUT"))
+	overrideDuration(&cfg.Gateway.ResumeGrace, os.Getenv("GAME_GATEWAY_RESUME_GRACE"))
+	overrideDuration(&cfg.Gateway.RequestTimeout, os.Getenv("GAME_GATEWAY_REQUEST_TIMEOUT"))
+	overrideDuration(&cfg.Service.ShutdownTimeout, os.Getenv("GAME_SERVICE_SHUTDOWN_TIMEOUT"))
+
+	overrideString(&cfg.Storage, *storageFlag)
+	overrideString(&cfg.Gateway.Addr, *gatewayAddrFlag)
+	overrideString(&cfg.Service.Addr, *serviceAddrFlag)
+	overrideString(&cfg.Mongo.Addr, *mongoAddrFlag)
+	overrideString(&cfg.Mongo.Database, *mongoDbFlag)
+	overrideString(&cfg.Redis.Addr, *redisAddrFlag)
+
+	checkDurations(cfg)
+	checkLimits(cfg)
+	return cfg
+}
+
+// Durations used by ticker or as wait must be positive, NewTicker panic
+// with 0, use default instead
+func checkDurations(cfg *Config) {
+	def := newConfig()
+	durations := []struct {
+		name string
+		dst  *time.Duration
+		def  time.Duration
+	}{
+		{"gateway.write_wait", &cfg.Gateway.WriteWait, def.Gateway.WriteWait},
+		{"gateway.pong_wait", &cfg.Gateway.PongWait, def.Gateway.PongWait},
+		{"gateway.flush_interval", &cfg.Gateway.FlushInterval, def.Gateway.FlushInterval},
+		{"gateway.shutdown_timeout", &cfg.Gateway.ShutdownTimeout, def.Gateway.ShutdownTimeout},
+		{"gateway.room_tick", &cfg.Gateway.RoomTick, def.Gateway.RoomTick},
+		{"gateway.ack_timeout", &cfg.Gateway.AckTimeout, def.Gateway.AckTimeout},
+		{"service.shutdown_timeout", &cfg.Service.ShutdownTimeout, def.Service.ShutdownTimeout},
+		{"matchmaking.interval", &cfg.Matchmaking.Interval, def.Matchmaking.Interval},
+	}
+	for _, d := range durations {
+		if *d.dst <= 0 {
+			log.Println("config", d.name, "must be positive, use default", d.def)
+			*d.dst = d.def
+		}
+	}
+}
+
+// Limits used as buffer or group size must be in range, e.g. slice by
+// negative one panic, room never joined, use default instead
+func checkLimits(cfg *Config) {
+	def := newConfig()
+	limits := []struct {
+		name string
+		dst  *int
+		min  int
+		def  int
+	}{
+		{"gateway.resume_buffer", &cfg.Gateway.ResumeBuffer, 1, def.Gateway.ResumeBuffer},
+		{"gateway.room_max_players", &cfg.Gateway.RoomMaxPlayers, 1, def.Gateway.RoomMaxPlayers},
+		{"matchmaking.max_party_size", &cfg.Matchmaking.MaxPartySize, 2, def.Matchmaking.MaxPartySize},
+	}
+	for _, l := range limits {
+		if *l.dst < l.min {
+			log.Println("config", l.name, "must be at least", l.min, "use default", l.def)
+			*l.dst = l.def
+		}
+	}
+}
+
+func isFlagSet(name string) (set bool) {
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return
+}
+
+func overrideString(dst *string, value string) {
+	if value != "" {
+		*dst = value
+	}
+}
+
+func overrideDuration(dst *time.Duration, value string) {
+	if value == "" {
+		return
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		log.Fatalln("config duration invalid:", value)
+	}
+	*dst = d
+}
+
+func overrideInt64(dst *int64, value string) {
+	if value == "" {
+		return
+	}
+	i, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		log.Fatalln("config int invalid:", value)
+	}
+	*dst = i
+}
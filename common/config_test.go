@@ -0,0 +1,72 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+func TestCheckDurations(t *testing.T) {
+	cfg := newConfig()
+	content := []byte(`
+gateway:
+  ack_timeout: 0s
+  room_tick: -1s
+  flush_interval: 0s
+  resume_grace: 0s
+matchmaking:
+  interval: 0s
+`)
+	if err := yaml.Unmarshal(content, cfg); err != nil {
+		t.Fatal(err)
+	}
+	checkDurations(cfg)
+
+	def := newConfig()
+	if cfg.Gateway.AckTimeout != def.Gateway.AckTimeout {
+		t.Fatalf("ack_timeout = %v, want default", cfg.Gateway.AckTimeout)
+	}
+	if cfg.Gateway.RoomTick != def.Gateway.RoomTick {
+		t.Fatalf("room_tick = %v, want default", cfg.Gateway.RoomTick)
+	}
+	if cfg.Gateway.FlushInterval != def.Gateway.FlushInterval {
+		t.Fatalf("flush_interval = %v, want default", cfg.Gateway.FlushInterval)
+	}
+	if cfg.Matchmaking.Interval != def.Matchmaking.Interval {
+		t.Fatalf("matchmaking interval = %v, want default", cfg.Matchmaking.Interval)
+	}
+	// 0 is valid, exit at once without grace
+	if cfg.Gateway.ResumeGrace != 0 {
+		t.Fatalf("resume_grace = %v, want 0", cfg.Gateway.ResumeGrace)
+	}
+	if cfg.Gateway.PongWait != 60*time.Second {
+		t.Fatalf("pong_wait = %v, want unchanged", cfg.Gateway.PongWait)
+	}
+}
+
+func TestCheckLimits(t *testing.T) {
+	cfg := newConfig()
+	content := []byte(`
+gateway:
+  resume_buffer: -1
+  room_max_players: 0
+matchmaking:
+  max_party_size: 1
+`)
+	if err := yaml.Unmarshal(content, cfg); err != nil {
+		t.Fatal(err)
+	}
+	checkLimits(cfg)
+
+	def := newConfig()
+	if cfg.Gateway.ResumeBuffer != def.Gateway.ResumeBuffer {
+		t.Fatalf("resume_buffer = %d, want default", cfg.Gateway.ResumeBuffer)
+	}
+	if cfg.Gateway.RoomMaxPlayers != def.Gateway.RoomMaxPlayers {
+		t.Fatalf("room_max_players = %d, want default", cfg.Gateway.RoomMaxPlayers)
+	}
+	if cfg.Matchmaking.MaxPartySize != def.Matchmaking.MaxPartySize {
+		t.Fatalf("max_party_size = %d, want default", cfg.Matchmaking.MaxPartySize)
+	}
+}
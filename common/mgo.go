@@ -1,10 +1,12 @@
 package common
 
 import (
+	"errors"
 	"log"
 	"sync"
 
 	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
 )
 
 var defaultMgo *Mgo
@@ -15,6 +17,30 @@ type Mgo struct {
 	database string
 }
 
+// Indexes ensured at start, unique ones guard data against concurrent writes
+var mgoIndexes = map[string][]mgo.Index{
+	"users": {
+		{Key: []string{"email"}, Unique: true},
+		{Key: []string{"sid"}, Unique: true, Sparse: true}, // sid is unset when empty
+	},
+	"chat_messages": { // and ttl index by chat_expire, see chatExpireIndexes
+		{Key: []string{"channel", "-_id"}},
+	},
+	"inbox_messages": {
+		{Key: []string{"to", "_id"}},
+	},
+	"leaderboard_archives": {
+		{Key: []string{"board", "period"}, Unique: true},
+	},
+	"friends": {
+		{Key: []string{"uid", "friend_uid"}, Unique: true},
+	},
+	"friend_requests": {
+		{Key: []string{"from", "to"}, Unique: true},
+		{Key: []string{"to"}},
+	},
+}
+
 // clone session after use must be closed
 type MgoSession struct {
 	session  *mgo.Session
@@ -23,7 +49,8 @@ type MgoSession struct {
 
 func GetMgo() *Mgo {
 	defaultMgoOnce.Do(func() {
-		defaultMgo = newMgo(":27017", "test")
+		cfg := GetConfig().Mongo
+		defaultMgo = newMgo(cfg.Addr, cfg.Database)
 	})
 	return defaultMgo
 }
@@ -36,17 +63,61 @@ func newMgo(server, database string) *Mgo {
 	}
 	session.SetMode(mgo.Monotonic, true)
 
-	return &Mgo{
+	m := &Mgo{
 		session:  session,
 		database: database,
 	}
+	m.ensureIndexes()
+
+	return m
+}
+
+// Failed unique index is fatal, as model relies on duplicate key error to
+// reject duplicates, e.g. existing duplicate data, fix data and restart.
+// Other failed index is logged
+func (m *Mgo) ensureIndexes() {
+	db := m.session.DB(m.database)
+
+	// Empty sid saved by old version, unset it for sparse unique index
+	if _, err := db.C("users").UpdateAll(bson.M{"sid": ""}, bson.M{"$unset": bson.M{"sid": 1}}); err != nil {
+		log.Println("mgo unset empty sid failed, err:", err)
+	}
+
+	for table, indexes := range mgoIndexes {
+		if table == "chat_messages" {
+			indexes = append(indexes, chatExpireIndexes()...)
+		}
+		for _, index := range indexes {
+			err := db.C(table).EnsureIndex(index)
+			if err != nil && index.Unique {
+				log.Fatalln("mgo ensure unique index failed, table:", table, "key:", index.Key, "err:", err)
+			}
+			if err != nil {
+				log.Println("mgo ensure index failed, table:", table, "key:", index.Key, "err:", err)
+			}
+		}
+	}
+}
+
+// Chat history removed by mgo ttl monitor after created, as it grows forever
+func chatExpireIndexes() []mgo.Index {
+	expire := GetConfig().Mongo.ChatExpire
+	if expire <= 0 {
+		return nil
+	}
+	return []mgo.Index{{Key: []string{"created_at"}, ExpireAfter: expire}}
 }
 
-func (m *Mgo) NewSession() *MgoSession {
+// Error if mgo dial failed at start
+func (m *Mgo) NewSession() (*MgoSession, error) {
+	if m == nil {
+		return nil, errors.New("mgo not connected")
+	}
+
 	return &MgoSession{
 		session:  m.session.Clone(),
 		database: m.database,
-	}
+	}, nil
 }
 
 func (m *MgoSession) C(table string) *mgo.Collection {
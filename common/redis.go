@@ -1,6 +1,7 @@
 package common
 
 import (
+	"strconv"
 	"sync"
 	"time"
 
@@ -16,18 +17,18 @@ type Redis struct {
 
 func GetRedis() *Redis {
 	defaultRedisOnce.Do(func() {
-		defaultRedis = newRedis(":6379")
+		defaultRedis = newRedis(GetConfig().Redis)
 	})
 	return defaultRedis
 }
 
-func newRedis(server string) *Redis {
+func newRedis(cfg RedisConfig) *Redis {
 	return &Redis{
 		pool: &redis.Pool{
-			MaxIdle:     3,
-			IdleTimeout: 240 * time.Second,
+			MaxIdle:     cfg.MaxIdle,
+			IdleTimeout: cfg.IdleTimeout,
 			Dial: func() (c redis.Conn, err error) {
-				c, err = redis.Dial("tcp", server)
+				c, err = redis.Dial("tcp", cfg.Addr)
 				return
 			},
 			TestOnBorrow: func(c redis.Conn, t time.Time) error {
@@ -53,6 +54,23 @@ func (r *Redis) Set(key, value string) (err error) {
 	return
 }
 
+func (r *Redis) SetEx(key string, seconds int, value string) (err error) {
+	conn := r.pool.Get()
+	defer conn.Close()
+
+	_, err = conn.Do("SETEX", key, seconds, value)
+	return
+}
+
+// Set only if key not exist, false if existed
+func (r *Redis) SetNxPx(key string, milliseconds int64, value string) (set bool, err error) {
+	conn := r.pool.Get()
+	defer conn.Close()
+
+	rst, err := conn.Do("SET", key, value, "PX", milliseconds, "NX")
+	return rst != nil, err
+}
+
 func (r *Redis) Del(key string) (err error) {
 	conn := r.pool.Get()
 	defer conn.Close()
@@ -61,6 +79,15 @@ func (r *Redis) Del(key string) (err error) {
 	return
 }
 
+// Error if key not exist
+func (r *Redis) Rename(key, newKey string) (err error) {
+	conn := r.pool.Get()
+	defer conn.Close()
+
+	_, err = conn.Do("RENAME", key, newKey)
+	return
+}
+
 func (r *Redis) HExists(key, field string) (rst bool, err error) {
 	conn := r.pool.Get()
 	defer conn.Close()
@@ -107,3 +134,162 @@ func (r *Redis) HMSet(args ...interface{}) (err error) {
 	_, err = conn.Do("HMSET", args...)
 	return
 }
+
+func (r *Redis) SAdd(args ...interface{}) (err error) {
+	conn := r.pool.Get()
+	defer conn.Close()
+
+	_, err = conn.Do("SADD", args...)
+	return
+}
+
+func (r *Redis) SRem(args ...interface{}) (err error) {
+	conn := r.pool.Get()
+	defer conn.Close()
+
+	_, err = conn.Do("SREM", args...)
+	return
+}
+
+func (r *Redis) SMembers(key string) (rst []string, err error) {
+	conn := r.pool.Get()
+	defer conn.Close()
+
+	rst, err = redis.Strings(conn.Do("SMEMBERS", key))
+	return
+}
+
+func (r *Redis) Expire(key string, seconds int) (err error) {
+	conn := r.pool.Get()
+	defer conn.Close()
+
+	_, err = conn.Do("EXPIRE", key, seconds)
+	return
+}
+
+// Sorted set member with score
+type ZMember struct {
+	Member string
+	Score  float64
+}
+
+// Keep greater score of member, work before redis 6.2 ZADD GT
+var zAddMaxScript = redis.NewScript(1, `
+local cur = redis.call('ZSCORE', KEYS[1], ARGV[2])
+if (not cur) or tonumber(ARGV[1]) > tonumber(cur) then
+	redis.call('ZADD', KEYS[1], ARGV[1], ARGV[2])
+	return ARGV[1]
+end
+return cur
+`)
+
+// Delete keys if value of first key is equal, for owner checked clear
+var delIfEqualScript = redis.NewScript(-1, `
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', unpack(KEYS))
+end
+return 0
+`)
+
+// Delete key and keys if key value equal, deleted false if not equal
+func (r *Redis) DelIfEqual(key, value string, keys ...string) (deleted bool, err error) {
+	conn := r.pool.Get()
+	defer conn.Close()
+
+	args := []interface{}{1 + len(keys), key}
+	for _, k := range keys {
+		args = append(args, k)
+	}
+	args = append(args, value)
+	n, err := redis.Int(delIfEqualScript.Do(conn, args...))
+	return n > 0, err
+}
+
+// Set with expire if key not exist or value is equal, for owner refresh
+var claimExScript = redis.NewScript(1, `
+local cur = redis.call('GET', KEYS[1])
+if (not cur) or cur == ARGV[2] then
+	redis.call('SET', KEYS[1], ARGV[2], 'EX', ARGV[1])
+	return 1
+end
+return 0
+`)
+
+// Set key with expire if not exist or same value, false if other value
+func (r *Redis) ClaimEx(key string, seconds int, value string) (claimed bool, err error) {
+	conn := r.pool.Get()
+	defer conn.Close()
+
+	n, err := redis.Int(claimExScript.Do(conn, key, seconds, value))
+	return n > 0, err
+}
+
+// Set score if member not exist or score greater, return score kept
+func (r *Redis) ZAddMax(key string, score float64, member string) (rst float64, err error) {
+	conn := r.pool.Get()
+	defer conn.Close()
+
+	rst, err = redis.Float64(zAddMaxScript.Do(conn, key, score, member))
+	return
+}
+
+// Members from high score to low, start and stop are 0-based and inclusive
+func (r *Redis) ZRevRangeWithScores(key string, start, stop int) (rst []ZMember, err error) {
+	conn := r.pool.Get()
+	defer conn.Close()
+
+	values, err := redis.Strings(conn.Do("ZREVRANGE", key, start, stop, "WITHSCORES"))
+	if err != nil {
+		return
+	}
+	for i := 0; i+1 < len(values); i += 2 {
+		score, err := strconv.ParseFloat(values[i+1], 64)
+		if err != nil {
+			return nil, err
+		}
+		rst = append(rst, ZMember{Member: values[i], Score: score})
+	}
+	return
+}
+
+// 0-based rank from high score, redis.ErrNil if member not exist
+func (r *Redis) ZRevRank(key, member string) (rst int, err error) {
+	conn := r.pool.Get()
+	defer conn.Close()
+
+	rst, err = redis.Int(conn.Do("ZREVRANK", key, member))
+	return
+}
+
+func (r *Redis) Publish(channel, message string) (err error) {
+	conn := r.pool.Get()
+	defer conn.Close()
+
+	_, err = conn.Do("PUBLISH", channel, message)
+	return
+}
+
+// Subscribe channels and block, handler is called in same gorotine,
+// return when conn broken, caller should subscribe again
+func (r *Redis) Subscribe(handler func(channel string, data []byte), channels ...string) (err error) {
+	conn := r.pool.Get()
+	defer conn.Close()
+
+	psc := redis.PubSubConn{Conn: conn}
+	args := make([]interface{}, len(channels))
+	for i, channel := range channels {
+		args[i] = channel
+	}
+	if err = psc.Subscribe(args...); err != nil {
+		return
+	}
+
+	for {
+		switch v := psc.Receive().(type) {
+		case redis.Message:
+			handler(v.Channel, v.Data)
+		case error:
+			return v
+		}
+	}
+}
@@ -0,0 +1,15 @@
+package common
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// Block until SIGINT or SIGTERM, for graceful shutdown
+func WaitSignal() os.Signal {
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sig)
+	return <-sig
+}
@@ -5,7 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"game_server/model"
+	"game_server/pb"
+	"log"
 	"net/http"
+	"strconv"
 
 	"golang.org/x/crypto/bcrypt"
 )
@@ -15,62 +18,168 @@ func login(w http.ResponseWriter, r *http.Request) {
 
 	email := r.FormValue("email")
 	if err := ValidateEmail(email); err != nil {
-		responseJsonError(w, err, http.StatusUnprocessableEntity)
+		responseJsonError(w, err)
 		return
 	}
 
 	password := r.FormValue("password")
 	if err := ValidatePassword(password); err != nil {
-		responseJsonError(w, err, http.StatusUnprocessableEntity)
+		responseJsonError(w, err)
 		return
 	}
 
-	usr := model.FindUserByEmail(email)
-	if usr == nil {
-		err := errors.New("email not exist")
-		responseJsonError(w, err, http.StatusNotFound)
+	usr, err := model.FindUserByEmail(email)
+	if err == model.ErrNotFound {
+		err := pb.NewError(pb.ErrorCode_ERR_EMAIL_NOT_EXIST, "email not exist")
+		responseJsonError(w, err)
+		return
+	}
+	if err != nil {
+		responseJsonInternalError(w, err)
 		return
 	}
 
 	if err := bcrypt.CompareHashAndPassword([]byte(usr.GetPassword()), []byte(password)); err != nil {
-		err := errors.New("password wrong")
-		responseJsonError(w, err, http.StatusBadRequest)
+		err := pb.NewError(pb.ErrorCode_ERR_PASSWORD_WRONG, "password wrong")
+		responseJsonError(w, err)
 		return
 	}
 
-	// new sid for user
-	sid := UniqueId()
-	usr.UpdateSid(sid)
+	sid, err := issueSid(usr)
+	if err != nil {
+		responseJsonInternalError(w, err)
+		return
+	}
 
 	rsp := make(map[string]string)
 	rsp["sid"] = sid
+	rsp["expires_in"] = strconv.Itoa(int(model.SidExpire.Seconds()))
 
 	responseJson(w, rsp)
 }
 
+// Rotate token, old token is invalid after
+func refresh(w http.ResponseWriter, r *http.Request) {
+	r.ParseForm()
+
+	usr, err := authUser(r)
+	if err != nil {
+		responseJsonError(w, err)
+		return
+	}
+
+	sid, err := issueSid(usr)
+	if err != nil {
+		responseJsonInternalError(w, err)
+		return
+	}
+
+	rsp := make(map[string]string)
+	rsp["sid"] = sid
+	rsp["expires_in"] = strconv.Itoa(int(model.SidExpire.Seconds()))
+
+	responseJson(w, rsp)
+}
+
+// Revoke token, and kick live client
+func logout(w http.ResponseWriter, r *http.Request) {
+	r.ParseForm()
+
+	usr, err := authUser(r)
+	if err != nil {
+		responseJsonError(w, err)
+		return
+	}
+
+	if err := model.ClearSidStorage(usr.GetSid()); err != nil {
+		responseJsonInternalError(w, err)
+		return
+	}
+	if err := usr.UpdateSid(""); err != nil {
+		responseJsonInternalError(w, err)
+		return
+	}
+
+	KickUser(usr.GetId(), "logout")
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Find user by token, token must be storage at redis,
+// error is pb.Error if token invalid, else model error
+func authUser(r *http.Request) (*model.User, error) {
+	sid := r.FormValue("token")
+	if sid == "" {
+		return nil, pb.NewError(pb.ErrorCode_ERR_TOKEN_MISSING, "no token")
+	}
+
+	uid, err := model.LoadUidBySid(sid)
+	if err == model.ErrNotFound {
+		return nil, pb.NewError(pb.ErrorCode_ERR_TOKEN_INVALID, "token invaild")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	usr, err := model.FindUserById(uid)
+	if err == model.ErrNotFound || (err == nil && usr.GetSid() != sid) {
+		return nil, pb.NewError(pb.ErrorCode_ERR_TOKEN_INVALID, "token invaild")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return usr, nil
+}
+
+// New sid for user, and revoke old one
+func issueSid(usr *model.User) (string, error) {
+	if old := usr.GetSid(); old != "" {
+		if err := model.ClearSidStorage(old); err != nil {
+			return "", err
+		}
+	}
+
+	sid := UniqueId()
+	if sid == "" {
+		return "", errors.New("generate sid failed")
+	}
+	if err := usr.UpdateSid(sid); err != nil {
+		return "", err
+	}
+	if err := usr.StorageSid(sid); err != nil {
+		return "", err
+	}
+
+	return sid, nil
+}
+
 func register(w http.ResponseWriter, r *http.Request) {
 	r.ParseForm()
 
 	email := r.FormValue("email")
 	if err := ValidateEmail(email); err != nil {
-		responseJsonError(w, err, http.StatusUnprocessableEntity)
+		responseJsonError(w, err)
 		return
 	}
 
 	password := r.FormValue("password")
 	if err := ValidatePassword(password); err != nil {
-		responseJsonError(w, err, http.StatusUnprocessableEntity)
+		responseJsonError(w, err)
 		return
 	}
 
-	usr := model.FindUserByEmail(email)
-	if usr != nil {
-		err := errors.New("email existed")
-		responseJsonError(w, err, http.StatusUnprocessableEntity)
+	// Email unique index decide, ensured at start or gateway not started
+	_, err := model.CreateUser(email, password)
+	if err == model.ErrDuplicate {
+		err := pb.NewError(pb.ErrorCode_ERR_EMAIL_EXISTED, "email existed")
+		responseJsonError(w, err)
+		return
+	}
+	if err != nil {
+		responseJsonInternalError(w, err)
 		return
 	}
-
-	model.CreateUser(email, password)
 	w.WriteHeader(http.StatusNoContent)
 }
 
@@ -81,17 +190,28 @@ func responseJson(w http.ResponseWriter, rst interface{}) {
 }
 
 type ErrorBag struct {
-	Code int    `json:"code"`
-	Msg  string `json:"msg"`
+	Code    int          `json:"code"`     // http status
+	ErrCode pb.ErrorCode `json:"err_code"` // same as pb.Error code
+	Msg     string       `json:"msg"`
 }
 
-func responseJsonError(w http.ResponseWriter, err error, code int) {
+// Http status is mapped from error code, see pb.ToError,
+// error not pb.Error is logged and response as internal error
+func responseJsonError(w http.ResponseWriter, err error) {
+	if _, ok := err.(*pb.Error); !ok {
+		log.Println(err)
+	}
+
+	pbErr := pb.ToError(err)
+	code := pbErr.HTTPStatus()
+
 	w.Header().Set("Content-type", "application/json;	charset=utf-8")
 	w.Header().Set("X-Content-Type-Options", "nosniff")
 	w.WriteHeader(code)
 	errorBag := &ErrorBag{
 		code,
-		err.Error(),
+		pbErr.GetCode(),
+		pbErr.GetMessage(),
 	}
 	content, _ := json.Marshal(errorBag)
 
@@ -99,8 +219,8 @@ func responseJsonError(w http.ResponseWriter, err error, code int) {
 }
 
 func responseJsonInternalError(w http.ResponseWriter, err error) {
-	code := http.StatusInternalServerError
-	err = errors.New("server error")
+	log.Println(err)
+	err = pb.NewError(pb.ErrorCode_ERR_INTERNAL, "server error")
 
-	responseJsonError(w, err, code)
+	responseJsonError(w, err)
 }
@@ -0,0 +1,248 @@
+package main
+
+import (
+	"game_server/model"
+	"game_server/pb"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// Chat channel name, all clients are in world channel
+const (
+	worldChannel         = "world"
+	roomChannelPrefix    = "room:"
+	guildChannelPrefix   = "guild:"
+	privateChannelPrefix = "private:"
+
+	maxChannelLength = 64
+
+	defaultHistoryLimit = 20
+	maxHistoryLimit     = 100
+)
+
+// Only room and guild channel can be joined
+func ValidateJoinChannel(channel string) error {
+	if len(channel) > maxChannelLength {
+		return pb.NewError(pb.ErrorCode_ERR_CHANNEL_INVALID, "channel too long")
+	}
+
+	for _, prefix := range []string{roomChannelPrefix, guildChannelPrefix} {
+		if strings.HasPrefix(channel, prefix) && len(channel) > len(prefix) {
+			return nil
+		}
+	}
+
+	return pb.NewError(pb.ErrorCode_ERR_CHANNEL_INVALID, "channel invalid")
+}
+
+func (c *Client) InChannel(channel string) bool {
+	if channel == worldChannel {
+		return true
+	}
+
+	c.channelsMu.Lock()
+	defer c.channelsMu.Unlock()
+	return c.channels[channel]
+}
+
+// handle req
+func (c *Client) JoinChannel(req *pb.Req) {
+	channel := req.GetJoinChannelReq().GetChannel()
+	if err := c.joinChannel(channel); err != nil {
+		c.Send(pb.MakeRsp_Error(req.GetMid(), err))
+		return
+	}
+	c.Send(pb.MakeRsp_JoinChannelRsp(req.GetMid(), channel))
+}
+
+// Join channel as member of room or guild only, checked at server
+func (c *Client) joinChannel(channel string) error {
+	if err := ValidateJoinChannel(channel); err != nil {
+		return err
+	}
+
+	if strings.HasPrefix(channel, roomChannelPrefix) {
+		// lock until joined, so leaving room at same time leave channel after
+		c.roomMu.Lock()
+		defer c.roomMu.Unlock()
+		if c.room == nil || roomChannelPrefix+c.room.id != channel {
+			return pb.NewError(pb.ErrorCode_ERR_PERMISSION_DENIED, "room not joined")
+		}
+	}
+	if strings.HasPrefix(channel, guildChannelPrefix) {
+		// no guild membership to check yet
+		return pb.NewError(pb.ErrorCode_ERR_PERMISSION_DENIED, "guild not joined")
+	}
+
+	c.channelsMu.Lock()
+	c.channels[channel] = true
+	c.channelsMu.Unlock()
+	c.hub.join <- &channelMember{channel: channel, client: c}
+	return nil
+}
+
+// handle req
+func (c *Client) LeaveChannel(req *pb.Req) {
+	channel := req.GetLeaveChannelReq().GetChannel()
+	if !c.InChannel(channel) || channel == worldChannel {
+		c.Send(pb.MakeRsp_Error(req.GetMid(), pb.NewError(pb.ErrorCode_ERR_CHANNEL_NOT_JOINED, "channel not joined")))
+		return
+	}
+
+	c.leaveChannel(channel)
+
+	c.Send(pb.MakeRsp_LeaveChannelRsp(req.GetMid(), channel))
+}
+
+// Leave channel if joined, e.g. room channel after left room
+func (c *Client) leaveChannel(channel string) {
+	c.channelsMu.Lock()
+	joined := c.channels[channel]
+	delete(c.channels, channel)
+	c.channelsMu.Unlock()
+
+	if joined {
+		c.hub.leave <- &channelMember{channel: channel, client: c}
+	}
+}
+
+// handle req, private history use private:<uid> as channel
+func (c *Client) GetChatHistory(req *pb.Req) {
+	historyReq := req.GetGetChatHistoryReq()
+
+	channel := historyReq.GetChannel()
+	if channel == "" {
+		channel = worldChannel
+	}
+
+	before := historyReq.GetBefore()
+	if before != "" && !bson.IsObjectIdHex(before) {
+		c.Send(pb.MakeRsp_Error(req.GetMid(), pb.NewError(pb.ErrorCode_ERR_CURSOR_INVALID, "cursor invalid")))
+		return
+	}
+
+	limit := int(historyReq.GetLimit())
+	if limit <= 0 {
+		limit = defaultHistoryLimit
+	} else if limit > maxHistoryLimit {
+		limit = maxHistoryLimit
+	}
+
+	historyChannel := channel
+	if strings.HasPrefix(channel, privateChannelPrefix) {
+		target := strings.TrimPrefix(channel, privateChannelPrefix)
+		if target == "" {
+			c.Send(pb.MakeRsp_Error(req.GetMid(), pb.NewError(pb.ErrorCode_ERR_CHANNEL_INVALID, "channel invalid")))
+			return
+		}
+		historyChannel = model.PrivateChatChannel(c.uid, target)
+	} else if !c.InChannel(channel) {
+		c.Send(pb.MakeRsp_Error(req.GetMid(), pb.NewError(pb.ErrorCode_ERR_CHANNEL_NOT_JOINED, "channel not joined")))
+		return
+	}
+
+	msgs, err := model.FindChatMessages(historyChannel, before, limit)
+	if err != nil {
+		log.Println(err)
+		c.Send(pb.MakeRsp_Error(req.GetMid(), err))
+		return
+	}
+
+	rsp := &pb.GetChatHistoryRsp{Channel: channel}
+	for _, msg := range msgs {
+		rsp.Messages = append(rsp.Messages, chatMessageToPush(channel, msg))
+	}
+	if len(msgs) == limit {
+		rsp.Before = msgs[0].Id.Hex()
+	}
+
+	c.Send(pb.MakeRsp_GetChatHistoryRsp(req.GetMid(), rsp))
+}
+
+// handle notify, private chat send to target user and echo to sender,
+// other chat send to channel members at all gateways
+func (c *Client) Chat(ntf *pb.Notify) {
+	chatNtf := ntf.GetChatNotify()
+	msg := chatNtf.GetMessage()
+
+	channel := chatNtf.GetChannel()
+	if channel == "" {
+		channel = worldChannel
+	}
+
+	if strings.HasPrefix(channel, privateChannelPrefix) {
+		target := strings.TrimPrefix(channel, privateChannelPrefix)
+		if target == "" || target == c.uid {
+			return
+		}
+
+		push := c.newChatPush(privateChannelPrefix+c.uid, msg)
+		if err := model.CreateChatMessage(chatPushToMessage(model.PrivateChatChannel(c.uid, target), push)); err != nil {
+			log.Println(err) // still deliver, only history lost
+		}
+
+		data, _ := proto.Marshal(pb.MakePush_ChatPush(push))
+		PublishPush(target, data)
+
+		// echo with same id, channel as target
+		echo := proto.Clone(push).(*pb.ChatPush)
+		echo.Channel = channel
+		c.Send(pb.MakePush_ChatPush(echo))
+		return
+	}
+
+	if !c.InChannel(channel) {
+		log.Println("chat to channel not joined, uid:", c.uid, "channel:", channel)
+		return
+	}
+
+	push := c.newChatPush(channel, msg)
+	if err := model.CreateChatMessage(chatPushToMessage(channel, push)); err != nil {
+		log.Println(err) // still deliver, only history lost
+	}
+
+	data, _ := proto.Marshal(pb.MakePush_ChatPush(push))
+	if channel == worldChannel {
+		PublishBroadcast(data)
+	} else {
+		PublishChat(channel, data)
+	}
+}
+
+// Sender identity is from client, never trust notify
+func (c *Client) newChatPush(channel, msg string) *pb.ChatPush {
+	return &pb.ChatPush{
+		Message: msg,
+		Channel: channel,
+		Id:      bson.NewObjectId().Hex(),
+		Uid:     c.uid,
+		Name:    c.GetName(),
+		Time:    time.Now().UnixNano() / int64(time.Millisecond),
+	}
+}
+
+func chatPushToMessage(channel string, push *pb.ChatPush) *model.ChatMessage {
+	return &model.ChatMessage{
+		Id:        bson.ObjectIdHex(push.GetId()),
+		Channel:   channel,
+		Uid:       push.GetUid(),
+		Name:      push.GetName(),
+		Message:   push.GetMessage(),
+		CreatedAt: time.Unix(0, push.GetTime()*int64(time.Millisecond)),
+	}
+}
+
+func chatMessageToPush(channel string, msg *model.ChatMessage) *pb.ChatPush {
+	return &pb.ChatPush{
+		Message: msg.Message,
+		Channel: channel,
+		Id:      msg.Id.Hex(),
+		Uid:     msg.Uid,
+		Name:    msg.Name,
+		Time:    msg.CreatedAt.UnixNano() / int64(time.Millisecond),
+	}
+}
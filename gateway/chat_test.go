@@ -0,0 +1,35 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestJoinChannelMember(t *testing.T) {
+	c := newTestClient("u1")
+	c.hub = GetHub()
+	c.channels = make(map[string]bool)
+
+	room := newRoom("r1", 2, c.hub)
+	if err := c.joinChannel(roomChannelPrefix + room.id); err == nil {
+		t.Fatal("joined room channel before joining room")
+	}
+	if err := c.joinChannel(guildChannelPrefix + "g1"); err == nil {
+		t.Fatal("joined guild channel without membership")
+	}
+
+	if _, err := room.Join(c); err != nil {
+		t.Fatal(err)
+	}
+	c.room = room
+	if err := c.joinChannel(roomChannelPrefix + "other"); err == nil {
+		t.Fatal("joined channel of other room")
+	}
+	if err := c.joinChannel(roomChannelPrefix + room.id); err != nil {
+		t.Fatal(err)
+	}
+
+	c.leaveRoom()
+	if c.InChannel(roomChannelPrefix + room.id) {
+		t.Fatal("room channel kept after left room")
+	}
+}
@@ -2,8 +2,10 @@ package main
 
 import (
 	"context"
+	"game_server/common"
 	"game_server/model"
 	"game_server/pb"
+	"io"
 	"log"
 	"sync"
 	"time"
@@ -13,66 +15,92 @@ import (
 	"github.com/gorilla/websocket"
 )
 
-const (
-	writeWait      = 10 * time.Second
-	pongWait       = 60 * time.Second
-	pingPeriod     = (pongWait * 9) / 10
-	maxMessageSize = 512
-)
-
-// Each client as conn, with ctx to control gorotine cancel
+// Each client as conn, with ctx to control gorotine cancel, client is kept
+// in resume grace after conn dropped, so reconnect attach new conn to it
 type Client struct {
 	ctx       context.Context
 	cancel    func()
 	closeOnce sync.Once
-	conn      *websocket.Conn
 	hub       *Hub
-	send      chan []byte
-	sid       string
 	uid       string
+	owner     string // claim of user storage, unique per session
+	ended     bool   // session released at exit and not claimed by new login
+
+	nameMu sync.Mutex
+	name   string // display name, changed by profile update
+
+	limiter *clientLimiter
+
+	channelsMu sync.Mutex
+	channels   map[string]bool // joined chat channels, except world
+
+	roomMu sync.Mutex
+	room   *Room // joined game room, at most one
+
+	pushQueue chan *pushMessage // pushes from hub, not sequenced yet
+
+	connMu   sync.Mutex
+	conn     *websocket.Conn // nil when dropped, wait resume
+	send     chan []byte     // queue of conn, new one for each conn
+	connStop chan struct{}   // closed to stop pumps of conn
+	grace    *time.Timer     // exit if not resumed
+	exited   bool
+	pushSeq  uint64     // last push seq
+	pushes   []seqPush  // recent pushes for replay, oldest first
+	unacked  []*seqPush // critical pushes not acked, oldest first
+	acking   bool       // client ever acked, redeliver only then
 }
 
-func newClient(conn *websocket.Conn, hub *Hub, uid string) *Client {
+func newClient(conn *websocket.Conn, hub *Hub, uid, owner, name string) *Client {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	client := &Client{
 		ctx:    ctx,
 		cancel: cancel,
-		conn:   conn,
 		hub:    hub,
-		send:   make(chan []byte),
 		uid:    uid,
+		owner:  owner,
+		name:   name,
+
+		limiter:   newClientLimiter(),
+		channels:  make(map[string]bool),
+		pushQueue: make(chan *pushMessage, common.GetConfig().Gateway.ResumeBuffer+sendBufferSize),
 	}
+	go client.pushPump()
 
 	client.hub.register <- client
 
-	go client.readPump()
-	go client.writePump()
+	client.connMu.Lock()
+	client.attachLocked(conn)
+	client.queueLocked(client.sessionPush(false, false))
+	client.connMu.Unlock()
 
 	return client
 }
 
 // Use proto3 to unserialize data, and forward to service
-func (c *Client) readPump() {
-	defer func() {
-		c.Exit()
-	}()
-
-	c.conn.SetReadLimit(maxMessageSize)
-	c.conn.SetReadDeadline(time.Now().Add(pongWait))
-	c.conn.SetPongHandler(func(string) error { c.conn.SetReadDeadline(time.Now().Add(pongWait)); return nil })
-	c.conn.SetCloseHandler(func(int, string) error { c.Exit(); return nil })
+// Conn closed by client means leave, other read error drop conn for resume
+func (c *Client) readPump(conn *websocket.Conn, stop chan struct{}) {
+	cfg := common.GetConfig().Gateway
+	conn.SetReadLimit(cfg.MaxMessageSize)
+	conn.SetReadDeadline(time.Now().Add(cfg.PongWait))
+	conn.SetPongHandler(func(string) error { conn.SetReadDeadline(time.Now().Add(cfg.PongWait)); return nil })
+	conn.SetCloseHandler(func(int, string) error { c.Exit(); return nil })
 
 	for {
 		select {
 		case <-c.ctx.Done(): // exit gorotine
+			c.Exit()
+			return
+		case <-stop: // conn dropped or replaced
 			return
 		default: // handle msg
-			_, data, err := c.conn.ReadMessage()
+			_, data, err := conn.ReadMessage()
 			if err != nil {
 				if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
 					log.Println(err)
 				}
+				c.drop(conn)
 				return
 			}
 
@@ -88,106 +116,212 @@ func (c *Client) readPump() {
 			// use gorotine to handle sync as async ## !important
 			switch msg.GetMessage().(type) {
 			case *pb.Message_Req:
-				// each rsp use same mid from req
-				switch req := msg.GetReq(); req.GetReq().(type) {
-				case *pb.Req_GetUserInfoReq:
-					go c.GetUserInfo(req)
+				req := msg.GetReq()
+				ok, abused := c.limiter.allow(req.GetReq())
+				if abused {
+					c.ExitWithReason("too many requests")
+					return
+				}
+				if !ok {
+					go c.Send(pb.MakeRsp_Error(req.GetMid(), pb.NewError(pb.ErrorCode_ERR_TOO_MANY_REQUESTS, "too many requests")))
+					continue
 				}
+				go c.handleReq(req)
 			case *pb.Message_Notify:
-				switch ntf := msg.GetNotify(); ntf.GetNotify().(type) {
-				case *pb.Notify_ChatNotify:
-					go c.Chat(ntf)
+				ntf := msg.GetNotify()
+				ok, abused := c.limiter.allow(ntf.GetNotify())
+				if abused {
+					c.ExitWithReason("too many requests")
+					return
 				}
+				if !ok {
+					continue
+				}
+				go c.handleNotify(ntf)
 			}
 		}
 	}
 }
 
-func (c *Client) writePump() {
-	ticker := time.NewTicker(pingPeriod)
-	defer func() {
-		ticker.Stop()
-		c.Exit()
-	}()
+// Write error drop conn for resume, unsent pushes are replayed then,
+// send is queue of this conn only, never written to conn replaced
+func (c *Client) writePump(conn *websocket.Conn, send chan []byte, stop chan struct{}) {
+	cfg := common.GetConfig().Gateway
+	ticker := time.NewTicker(cfg.PongWait * 9 / 10)
+	defer ticker.Stop()
+	ackTicker := time.NewTicker(cfg.AckTimeout)
+	defer ackTicker.Stop()
 
 	for {
+		// stop first, select choose randomly when both ready
+		select {
+		case <-stop:
+			return
+		default:
+		}
+
 		select {
 		case <-c.ctx.Done(): // exit gorotine
+			c.Exit()
 			return
+		case <-stop: // conn dropped or replaced
+			return
+		case <-ackTicker.C: // redeliver not acked
+			c.redeliver(cfg.AckTimeout)
 		case <-ticker.C: // handle ping
-			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
-			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+			conn.SetWriteDeadline(time.Now().Add(cfg.WriteWait))
+			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				c.drop(conn)
 				return
 			}
-		case message, ok := <-c.send: // send
-			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
-			if !ok {
-				c.conn.WriteMessage(websocket.CloseMessage, []byte{})
-				return
-			}
-
-			w, err := c.conn.NextWriter(websocket.BinaryMessage)
+		case message := <-send: // send
+			conn.SetWriteDeadline(time.Now().Add(cfg.WriteWait))
+			w, err := conn.NextWriter(websocket.BinaryMessage)
 			if err != nil {
+				c.drop(conn)
 				return
 			}
-			w.Write(message)
+			writeFrame(w, message)
 
-			n := len(c.send)
+			// batch queued messages into same ws frame, each one length-prefixed
+			n := len(send)
 			for i := 0; i < n; i++ {
-				w.Write(<-c.send)
+				writeFrame(w, <-send)
 			}
 			if err := w.Close(); err != nil {
+				c.drop(conn)
 				return
 			}
 		}
 	}
 }
 
+// Write message with varint length prefix, so batched messages can be split
+// by client, same as protobuf.js decodeDelimited
+func writeFrame(w io.Writer, data []byte) {
+	w.Write(proto.EncodeVarint(uint64(len(data))))
+	w.Write(data)
+}
+
 func (c *Client) Exit() {
 	c.closeOnce.Do(func() {
-		// Save redis data to mgo, and clear
-		if model.UserStorageExists(c.uid) {
-			usr := model.LoadUserById(c.uid)
-			usr.Save()
-			usr.ClearStorage()
+		c.connMu.Lock()
+		c.exited = true
+		if c.grace != nil {
+			c.grace.Stop()
 		}
+		c.closeConnLocked()
+		c.connMu.Unlock()
+
+		if err := model.SavePendingPushes(c.uid, c.pendingPushes()); err != nil {
+			log.Println("save pending pushes failed, uid:", c.uid, "err:", err)
+		}
+		// Save redis data to mgo, and clear, keep redis data if save failed
+		released, err := saveUserStorage(c.uid, c.owner)
+		if err != nil {
+			log.Println("save user storage failed, uid:", c.uid, "err:", err)
+			if released, err = model.ReleaseUserSession(c.uid, c.owner); err != nil {
+				log.Println("release user session failed, uid:", c.uid, "err:", err)
+			}
+		}
+		// kick of relogin at other gateway is async, new session may be
+		// claimed already, or just after release
+		c.ended = released && !userOnline(c.uid)
+		if c.ended {
+			go cancelMatchOnExit(c.uid) // ticket may be of new session
+		}
+		c.leaveRoom()
 
 		c.hub.unregister <- c
-		c.conn.Close()
 		c.cancel()
 	})
 }
 
+// Clear is skipped if storage claimed by new session, at any gateway,
+// false then
+func saveUserStorage(uid, owner string) (bool, error) {
+	usr, err := model.LoadUserById(uid)
+	if err == model.ErrNotFound {
+		return model.ReleaseUserSession(uid, owner)
+	}
+	if err != nil {
+		return false, err
+	}
+	if err := usr.Save(); err != nil {
+		return false, err
+	}
+	return usr.ClearStorage(owner)
+}
+
+// Online if check failed, skip offline handling then
+func userOnline(uid string) bool {
+	online, err := model.UserOnline(uid)
+	if err != nil {
+		log.Println("check user online failed, uid:", uid, "err:", err)
+		return true
+	}
+	return online
+}
+
 // Send close control with reason
 func (c *Client) ExitWithReason(reason string) {
 	defer c.Exit()
-	err := c.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, reason), time.Now().Add(time.Second))
+
+	c.connMu.Lock()
+	conn := c.conn
+	c.connMu.Unlock()
+	if conn == nil {
+		return
+	}
+	err := conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, reason), time.Now().Add(time.Second))
 	if err != nil {
 		return
 	}
 }
 
+// Marshal message and send to client, push is sequenced and kept for replay,
+// others are dropped if conn dropped
+func (c *Client) Send(msg *pb.Message) {
+	if msg.GetPush() != nil {
+		push, err := newPushMessage(msg)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+		c.sendPush(push)
+		return
+	}
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	c.connMu.Lock()
+	c.queueLocked(data)
+	c.connMu.Unlock()
+}
+
+func (c *Client) GetName() string {
+	c.nameMu.Lock()
+	defer c.nameMu.Unlock()
+	return c.name
+}
+
+func (c *Client) SetName(name string) {
+	c.nameMu.Lock()
+	defer c.nameMu.Unlock()
+	c.name = name
+}
+
 // handle req
 func (c *Client) GetUserInfo(req *pb.Req) {
 	arg := &pb.String{Value: c.uid}
-	reply, err := GetGameServiceClient().GetUserInfo(context.TODO(), arg)
+	reply, err := GetGameServiceClient().GetUserInfo(c.ctx, arg)
 	rsp := &pb.Message{}
 	if err != nil {
-		rsp = pb.MakeRsp_Error(req.GetMid(), err.Error())
+		rsp = pb.MakeRsp_Error(req.GetMid(), err)
 	} else {
 		rsp = pb.MakeRsp_GetUserInfoRsp(req.GetMid(), reply)
 	}
-	data, _ := proto.Marshal(rsp)
-	c.send <- data
-}
-
-// handle notify
-func (c *Client) Chat(ntf *pb.Notify) {
-	chatNtf := ntf.GetChatNotify()
-	msg := chatNtf.GetMessage()
-
-	push := pb.MakePush_ChatPush(msg)
-
-	data, _ := proto.Marshal(push)
-	c.hub.broadcast <- []byte(data)
+	c.Send(rsp)
 }
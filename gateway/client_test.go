@@ -0,0 +1,37 @@
+package main
+
+import (
+	"game_server/model"
+	"testing"
+)
+
+func TestSaveUserStorageRelogin(t *testing.T) {
+	usr, err := model.CreateUser("relogin@test.com", "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := model.ClaimUserSession(usr.GetId(), "old"); err != nil {
+		t.Fatal(err)
+	}
+	if err := usr.Storage(); err != nil {
+		t.Fatal(err)
+	}
+
+	// new login at other gateway claim before old session exit
+	if err := model.ClaimUserSession(usr.GetId(), "new"); err != nil {
+		t.Fatal(err)
+	}
+	if released, err := saveUserStorage(usr.GetId(), "old"); err != nil || released {
+		t.Fatalf("released = %v, err = %v, want kept for new session", released, err)
+	}
+	if !userOnline(usr.GetId()) {
+		t.Fatal("new session offline")
+	}
+
+	if released, err := saveUserStorage(usr.GetId(), "new"); err != nil || !released {
+		t.Fatalf("released = %v, err = %v, want released", released, err)
+	}
+	if userOnline(usr.GetId()) {
+		t.Fatal("online after exit")
+	}
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"encoding/json"
+	"game_server/common"
+	"log"
+	"time"
+)
+
+// Gateways talk to each other through redis pub/sub,
+// each gateway subscribe all channels and handle msg for its own clients
+
+// Unique id of this gateway process
+var nodeId = UniqueId()
+
+// Subscribe cluster channels forever, resubscribe when redis conn broken
+func subscribeCluster(hub *Hub) {
+	for {
+		err := common.GetBroker().Subscribe(func(channel string, data []byte) {
+			handleClusterMessage(hub, channel, data)
+		}, common.BroadcastChannel, common.PushChannel, common.KickChannel, common.ChatChannel)
+		log.Println("cluster subscribe broken, err:", err)
+		time.Sleep(time.Second)
+	}
+}
+
+func handleClusterMessage(hub *Hub, channel string, data []byte) {
+	msg := &common.ClusterMessage{}
+	if err := json.Unmarshal(data, msg); err != nil {
+		log.Println(err)
+		return
+	}
+
+	// push decoded once here, never at hub for each client
+	var push *pushMessage
+	if channel != common.KickChannel {
+		var err error
+		if push, err = decodePushMessage(msg.Data); err != nil {
+			log.Println(err)
+			return
+		}
+	}
+
+	switch channel {
+	case common.BroadcastChannel:
+		hub.broadcast <- push
+	case common.PushChannel:
+		hub.push <- &userMessage{uid: msg.Uid, push: push}
+	case common.ChatChannel:
+		hub.publish <- &channelMessage{channel: msg.Chat, push: push}
+	case common.KickChannel:
+		if msg.Node == nodeId { // kicked at local already
+			return
+		}
+		// exit save storage, never block delivery of other messages
+		if client := hub.GetClient(msg.Uid); client != nil {
+			go client.ExitWithReason(msg.Reason)
+		}
+	}
+}
+
+func publishCluster(channel string, msg *common.ClusterMessage) {
+	msg.Node = nodeId
+	if err := common.PublishCluster(channel, msg); err != nil {
+		log.Println(err)
+	}
+}
+
+// Send data to all clients of all gateways
+func PublishBroadcast(data []byte) {
+	publishCluster(common.BroadcastChannel, &common.ClusterMessage{Data: data})
+}
+
+// Send data to user client, whichever gateway it connected
+func PublishPush(uid string, data []byte) {
+	publishCluster(common.PushChannel, &common.ClusterMessage{Uid: uid, Data: data})
+}
+
+// Send data to channel members of all gateways
+func PublishChat(channel string, data []byte) {
+	publishCluster(common.ChatChannel, &common.ClusterMessage{Chat: channel, Data: data})
+}
+
+// Kick user client at local gateway, and at other gateways
+func KickUser(uid, reason string) {
+	if client := GetHub().GetClient(uid); client != nil {
+		client.ExitWithReason(reason)
+	}
+	publishCluster(common.KickChannel, &common.ClusterMessage{Uid: uid, Reason: reason})
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"game_server/model"
+	"game_server/pb"
+	"log"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// Inbox messages delivered per batch on connect
+const inboxDeliverBatch = 100
+
+// handle notify, online target get push at once, offline one at next connect,
+// sender get echo with same id
+func (c *Client) DirectMessage(ntf *pb.Notify) {
+	dmNtf := ntf.GetDirectMessageNotify()
+	target := dmNtf.GetUid()
+	if target == "" || target == c.uid {
+		return
+	}
+
+	push := &pb.DirectMessagePush{
+		Id:      bson.NewObjectId().Hex(),
+		Uid:     c.uid,
+		Name:    c.GetName(),
+		To:      target,
+		Message: dmNtf.GetMessage(),
+		Time:    time.Now().UnixNano() / int64(time.Millisecond),
+	}
+
+	err := deliverDirectMessage(push)
+	if err == model.ErrNotFound {
+		log.Println("direct message to user not found, uid:", c.uid, "target:", target)
+		return
+	}
+	if err != nil {
+		log.Println("direct message failed, uid:", c.uid, "err:", err)
+		return
+	}
+
+	c.Send(pb.MakePush_DirectMessagePush(push))
+}
+
+// Local client first, then other gateway if user session alive, else inbox.
+// Local client in resume grace also get inbox, pushes are lost if grace
+// expired, and only kept as pending for client acking
+func deliverDirectMessage(push *pb.DirectMessagePush) error {
+	client := GetHub().GetClient(push.GetTo())
+	if client != nil && client.Connected() {
+		client.Send(pb.MakePush_DirectMessagePush(push))
+		return nil
+	}
+
+	online := false
+	if client == nil {
+		var err error
+		if online, err = model.UserOnline(push.GetTo()); err != nil {
+			return err
+		}
+	}
+	if online {
+		data, _ := proto.Marshal(pb.MakePush_DirectMessagePush(push))
+		PublishPush(push.GetTo(), data)
+		return nil
+	}
+
+	if _, err := model.FindUserById(push.GetTo()); err != nil {
+		return err
+	}
+	return model.CreateInboxMessage(&model.InboxMessage{
+		Id:        bson.ObjectIdHex(push.GetId()),
+		To:        push.GetTo(),
+		Uid:       push.GetUid(),
+		Name:      push.GetName(),
+		Message:   push.GetMessage(),
+		CreatedAt: time.Unix(0, push.GetTime()*int64(time.Millisecond)),
+	})
+}
+
+// Send inbox backlog to client just connected, removed after sent,
+// kept if client exited before
+func deliverInbox(client *Client) {
+	for {
+		msgs, err := model.FindInboxMessages(client.uid, inboxDeliverBatch)
+		if err != nil {
+			log.Println("find inbox failed, uid:", client.uid, "err:", err)
+			return
+		}
+		if len(msgs) == 0 {
+			return
+		}
+
+		for _, msg := range msgs {
+			client.Send(pb.MakePush_DirectMessagePush(&pb.DirectMessagePush{
+				Id:      msg.Id.Hex(),
+				Uid:     msg.Uid,
+				Name:    msg.Name,
+				To:      msg.To,
+				Message: msg.Message,
+				Time:    msg.CreatedAt.UnixNano() / int64(time.Millisecond),
+			}))
+		}
+		if client.ctx.Err() != nil {
+			return
+		}
+
+		if err := model.DeleteInboxMessages(client.uid, msgs[len(msgs)-1].Id); err != nil {
+			log.Println("delete inbox failed, uid:", client.uid, "err:", err)
+			return
+		}
+		if len(msgs) < inboxDeliverBatch {
+			return
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"game_server/model"
+	"game_server/pb"
+	"testing"
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+)
+
+func TestDeliverDirectMessageOffline(t *testing.T) {
+	usr, err := model.CreateUser("offline@test.com", "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	push := &pb.DirectMessagePush{
+		Id:      bson.NewObjectId().Hex(),
+		To:      usr.GetId(),
+		Uid:     bson.NewObjectId().Hex(),
+		Message: "hello",
+	}
+	if err := deliverDirectMessage(push); err != nil {
+		t.Fatal(err)
+	}
+
+	msgs, err := model.FindInboxMessages(usr.GetId(), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(msgs) != 1 || msgs[0].Message != "hello" {
+		t.Fatalf("inbox = %+v, want one message", msgs)
+	}
+}
+
+func TestDeliverDirectMessageStaleStorage(t *testing.T) {
+	usr, err := model.CreateUser("stale@test.com", "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// storage left by crashed gateway, session expired
+	if err := usr.Storage(); err != nil {
+		t.Fatal(err)
+	}
+
+	push := &pb.DirectMessagePush{
+		Id:      bson.NewObjectId().Hex(),
+		To:      usr.GetId(),
+		Uid:     bson.NewObjectId().Hex(),
+		Message: "hello",
+	}
+	if err := deliverDirectMessage(push); err != nil {
+		t.Fatal(err)
+	}
+
+	msgs, err := model.FindInboxMessages(usr.GetId(), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(msgs) != 1 {
+		t.Fatalf("inbox = %d messages, want 1", len(msgs))
+	}
+}
+
+func TestDeliverDirectMessageGrace(t *testing.T) {
+	usr, err := model.CreateUser("grace@test.com", "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := model.ClaimUserSession(usr.GetId(), "owner"); err != nil {
+		t.Fatal(err)
+	}
+	// local client dropped conn, wait resume
+	c := newTestClient(usr.GetId())
+	hub := GetHub()
+	hub.register <- c
+	defer func() { hub.unregister <- c }()
+	for hub.GetClient(usr.GetId()) != c {
+		time.Sleep(time.Millisecond)
+	}
+
+	push := &pb.DirectMessagePush{
+		Id:      bson.NewObjectId().Hex(),
+		To:      usr.GetId(),
+		Uid:     bson.NewObjectId().Hex(),
+		Message: "hello",
+	}
+	if err := deliverDirectMessage(push); err != nil {
+		t.Fatal(err)
+	}
+
+	msgs, err := model.FindInboxMessages(usr.GetId(), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(msgs) != 1 {
+		t.Fatalf("inbox = %d messages, want 1", len(msgs))
+	}
+	if c.pushSeq != 0 {
+		t.Fatalf("seq = %d, want not pushed to client in grace", c.pushSeq)
+	}
+}
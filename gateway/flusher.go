@@ -0,0 +1,55 @@
+package main
+
+import (
+	"game_server/model"
+	"log"
+	"time"
+)
+
+// Write dirty player data from redis to mgo on interval,
+// so gateway crash lose at most one interval of progress
+
+type flusher struct {
+	interval time.Duration
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+func newFlusher(interval time.Duration) *flusher {
+	f := &flusher{
+		interval: interval,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	go f.run()
+	return f
+}
+
+func (f *flusher) run() {
+	defer close(f.done)
+
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			f.flush()
+		case <-f.stop:
+			f.flush()
+			return
+		}
+	}
+}
+
+func (f *flusher) flush() {
+	if err := model.FlushDirtyUsers(); err != nil {
+		log.Println("flush dirty users failed, err:", err)
+	}
+}
+
+// Stop loop after a last flush, block until done
+func (f *flusher) Stop() {
+	close(f.stop)
+	<-f.done
+}
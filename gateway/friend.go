@@ -0,0 +1,194 @@
+package main
+
+import (
+	"game_server/model"
+	"game_server/pb"
+	"log"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Friend info with display name, online if user session alive at any
+// gateway, it expires soon after gateway crashed
+func friendInfo(uid string) (*pb.Friend, error) {
+	usr, err := model.GetUserById(uid)
+	if err != nil {
+		return nil, err
+	}
+	online, err := model.UserOnline(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Friend{
+		Uid:    uid,
+		Name:   usr.GetName(),
+		Online: online,
+	}, nil
+}
+
+// Push presence to all friends at all gateways, called by hub
+func notifyFriendPresence(uid, name string, online bool) {
+	friends, err := model.FindFriends(uid)
+	if err != nil {
+		log.Println("find friends failed, uid:", uid, "err:", err)
+		return
+	}
+	if len(friends) == 0 {
+		return
+	}
+
+	data, _ := proto.Marshal(pb.MakePush_FriendPresencePush(&pb.Friend{
+		Uid:    uid,
+		Name:   name,
+		Online: online,
+	}))
+	for _, f := range friends {
+		PublishPush(f.FriendUid, data)
+	}
+}
+
+// handle req
+func (c *Client) SendFriend(req *pb.Req) {
+	target := req.GetSendFriendReq().GetUid()
+	if target == c.uid {
+		c.Send(pb.MakeRsp_Error(req.GetMid(), pb.NewError(pb.ErrorCode_ERR_FRIEND_SELF, "can not add self")))
+		return
+	}
+
+	_, err := model.GetUserById(target)
+	if err == model.ErrNotFound {
+		c.Send(pb.MakeRsp_Error(req.GetMid(), pb.NewError(pb.ErrorCode_ERR_USER_NOT_FOUND, "user not found")))
+		return
+	}
+	if err != nil {
+		log.Println(err)
+		c.Send(pb.MakeRsp_Error(req.GetMid(), err))
+		return
+	}
+
+	isFriend, err := model.IsFriend(c.uid, target)
+	if err != nil {
+		log.Println(err)
+		c.Send(pb.MakeRsp_Error(req.GetMid(), err))
+		return
+	}
+	if isFriend {
+		c.Send(pb.MakeRsp_Error(req.GetMid(), pb.NewError(pb.ErrorCode_ERR_FRIEND_EXISTED, "already friends")))
+		return
+	}
+
+	err = model.CreateFriendRequest(c.uid, target)
+	if err == model.ErrDuplicate {
+		c.Send(pb.MakeRsp_Error(req.GetMid(), pb.NewError(pb.ErrorCode_ERR_FRIEND_REQUEST_EXISTED, "request sent already")))
+		return
+	}
+	if err != nil {
+		log.Println(err)
+		c.Send(pb.MakeRsp_Error(req.GetMid(), err))
+		return
+	}
+
+	data, _ := proto.Marshal(pb.MakePush_FriendRequestPush(&pb.Friend{Uid: c.uid, Name: c.GetName(), Online: true}))
+	PublishPush(target, data)
+
+	c.Send(pb.MakeRsp_SendFriendRsp(req.GetMid(), target))
+}
+
+// handle req
+func (c *Client) AcceptFriend(req *pb.Req) {
+	from := req.GetAcceptFriendReq().GetUid()
+
+	err := model.AcceptFriendRequest(c.uid, from)
+	if err == model.ErrNotFound {
+		c.Send(pb.MakeRsp_Error(req.GetMid(), pb.NewError(pb.ErrorCode_ERR_FRIEND_REQUEST_NOT_FOUND, "request not found")))
+		return
+	}
+	if err != nil {
+		log.Println(err)
+		c.Send(pb.MakeRsp_Error(req.GetMid(), err))
+		return
+	}
+
+	data, _ := proto.Marshal(pb.MakePush_FriendAcceptPush(&pb.Friend{Uid: c.uid, Name: c.GetName(), Online: true}))
+	PublishPush(from, data)
+
+	friend, err := friendInfo(from)
+	if err != nil {
+		log.Println(err)
+		friend = &pb.Friend{Uid: from} // accepted already, rsp without detail
+	}
+	c.Send(pb.MakeRsp_AcceptFriendRsp(req.GetMid(), friend))
+}
+
+// handle req
+func (c *Client) DeclineFriend(req *pb.Req) {
+	from := req.GetDeclineFriendReq().GetUid()
+
+	err := model.DeclineFriendRequest(c.uid, from)
+	if err == model.ErrNotFound {
+		c.Send(pb.MakeRsp_Error(req.GetMid(), pb.NewError(pb.ErrorCode_ERR_FRIEND_REQUEST_NOT_FOUND, "request not found")))
+		return
+	}
+	if err != nil {
+		log.Println(err)
+		c.Send(pb.MakeRsp_Error(req.GetMid(), err))
+		return
+	}
+
+	c.Send(pb.MakeRsp_DeclineFriendRsp(req.GetMid(), from))
+}
+
+// handle req
+func (c *Client) RemoveFriend(req *pb.Req) {
+	uid := req.GetRemoveFriendReq().GetUid()
+
+	err := model.RemoveFriend(c.uid, uid)
+	if err == model.ErrNotFound {
+		c.Send(pb.MakeRsp_Error(req.GetMid(), pb.NewError(pb.ErrorCode_ERR_FRIEND_NOT_FOUND, "friend not found")))
+		return
+	}
+	if err != nil {
+		log.Println(err)
+		c.Send(pb.MakeRsp_Error(req.GetMid(), err))
+		return
+	}
+
+	c.Send(pb.MakeRsp_RemoveFriendRsp(req.GetMid(), uid))
+}
+
+// handle req, friends and received requests
+func (c *Client) GetFriendList(req *pb.Req) {
+	friends, err := model.FindFriends(c.uid)
+	if err != nil {
+		log.Println(err)
+		c.Send(pb.MakeRsp_Error(req.GetMid(), err))
+		return
+	}
+	requests, err := model.FindFriendRequests(c.uid)
+	if err != nil {
+		log.Println(err)
+		c.Send(pb.MakeRsp_Error(req.GetMid(), err))
+		return
+	}
+
+	rsp := &pb.GetFriendListRsp{}
+	for _, f := range friends {
+		info, err := friendInfo(f.FriendUid)
+		if err != nil {
+			log.Println("friend info failed, uid:", f.FriendUid, "err:", err)
+			continue
+		}
+		rsp.Friends = append(rsp.Friends, info)
+	}
+	for _, r := range requests {
+		info, err := friendInfo(r.From)
+		if err != nil {
+			log.Println("friend info failed, uid:", r.From, "err:", err)
+			continue
+		}
+		rsp.Requests = append(rsp.Requests, info)
+	}
+
+	c.Send(pb.MakeRsp_GetFriendListRsp(req.GetMid(), rsp))
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"game_server/model"
+	"testing"
+)
+
+func TestFriendInfoOnline(t *testing.T) {
+	usr, err := model.CreateUser("friend@test.com", "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// storage left by crashed gateway is not online
+	if err := usr.Storage(); err != nil {
+		t.Fatal(err)
+	}
+	info, err := friendInfo(usr.GetId())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.GetOnline() {
+		t.Fatal("online without session")
+	}
+
+	if err := model.ClaimUserSession(usr.GetId(), "owner"); err != nil {
+		t.Fatal(err)
+	}
+	if info, _ := friendInfo(usr.GetId()); !info.GetOnline() {
+		t.Fatal("offline with session")
+	}
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"game_server/pb"
+	"log"
+	"reflect"
+)
+
+// Handler for req, rsp must use same mid from req
+type ReqHandler func(c *Client, req *pb.Req)
+
+// Handler for notify, no rsp
+type NotifyHandler func(c *Client, ntf *pb.Notify)
+
+// Registry use oneof wrapper type as key, only write at init
+var reqHandlers = make(map[reflect.Type]ReqHandler)
+var notifyHandlers = make(map[reflect.Type]NotifyHandler)
+
+func init() {
+	RegisterReqHandler((*pb.Req_GetUserInfoReq)(nil), (*Client).GetUserInfo)
+	RegisterReqHandler((*pb.Req_JoinChannelReq)(nil), (*Client).JoinChannel)
+	RegisterReqHandler((*pb.Req_LeaveChannelReq)(nil), (*Client).LeaveChannel)
+	RegisterReqHandler((*pb.Req_GetChatHistoryReq)(nil), (*Client).GetChatHistory)
+	RegisterReqHandler((*pb.Req_SendFriendReq)(nil), (*Client).SendFriend)
+	RegisterReqHandler((*pb.Req_AcceptFriendReq)(nil), (*Client).AcceptFriend)
+	RegisterReqHandler((*pb.Req_DeclineFriendReq)(nil), (*Client).DeclineFriend)
+	RegisterReqHandler((*pb.Req_RemoveFriendReq)(nil), (*Client).RemoveFriend)
+	RegisterReqHandler((*pb.Req_GetFriendListReq)(nil), (*Client).GetFriendList)
+	RegisterReqHandler((*pb.Req_GetProfileReq)(nil), (*Client).GetProfile)
+	RegisterReqHandler((*pb.Req_UpdateProfileReq)(nil), (*Client).UpdateProfile)
+	RegisterReqHandler((*pb.Req_SubmitScoreReq)(nil), (*Client).SubmitScore)
+	RegisterReqHandler((*pb.Req_GetLeaderboardReq)(nil), (*Client).GetLeaderboard)
+	RegisterReqHandler((*pb.Req_JoinMatchReq)(nil), (*Client).JoinMatch)
+	RegisterReqHandler((*pb.Req_CancelMatchReq)(nil), (*Client).CancelMatch)
+	RegisterReqHandler((*pb.Req_CreateRoomReq)(nil), (*Client).CreateRoom)
+	RegisterReqHandler((*pb.Req_JoinRoomReq)(nil), (*Client).JoinRoom)
+	RegisterReqHandler((*pb.Req_LeaveRoomReq)(nil), (*Client).LeaveRoom)
+	RegisterReqHandler((*pb.Req_ReadyRoomReq)(nil), (*Client).ReadyRoom)
+
+	RegisterNotifyHandler((*pb.Notify_ChatNotify)(nil), (*Client).Chat)
+	RegisterNotifyHandler((*pb.Notify_DirectMessageNotify)(nil), (*Client).DirectMessage)
+	RegisterNotifyHandler((*pb.Notify_RoomInputNotify)(nil), (*Client).RoomInput)
+	RegisterNotifyHandler((*pb.Notify_AckNotify)(nil), (*Client).Ack)
+
+	SetRateLimit((*pb.Req_GetUserInfoReq)(nil), RateLimit{Rate: 1, Burst: 5})
+	SetRateLimit((*pb.Req_GetChatHistoryReq)(nil), RateLimit{Rate: 1, Burst: 5})
+	SetRateLimit((*pb.Req_SendFriendReq)(nil), RateLimit{Rate: 1, Burst: 5})
+	SetRateLimit((*pb.Req_GetFriendListReq)(nil), RateLimit{Rate: 1, Burst: 5})
+	SetRateLimit((*pb.Req_UpdateProfileReq)(nil), RateLimit{Rate: 0.2, Burst: 3})
+	SetRateLimit((*pb.Req_SubmitScoreReq)(nil), RateLimit{Rate: 0.2, Burst: 3})
+	SetRateLimit((*pb.Req_GetLeaderboardReq)(nil), RateLimit{Rate: 1, Burst: 5})
+	SetRateLimit((*pb.Req_JoinMatchReq)(nil), RateLimit{Rate: 1, Burst: 5})
+	SetRateLimit((*pb.Notify_RoomInputNotify)(nil), RateLimit{Rate: 30, Burst: 60}) // input every tick
+	SetRateLimit((*pb.Notify_ChatNotify)(nil), RateLimit{Rate: 1, Burst: 5})
+	SetRateLimit((*pb.Notify_DirectMessageNotify)(nil), RateLimit{Rate: 1, Burst: 5})
+	SetRateLimit((*pb.Notify_AckNotify)(nil), RateLimit{Rate: 2, Burst: 10}) // ack is cumulative, dropped one is fine
+
+	// rewards and mail by system, and pushes of player actions
+	SetCriticalPush((*pb.Push_SystemPush)(nil), true)
+	SetCriticalPush((*pb.Push_DirectMessagePush)(nil), true)
+	SetCriticalPush((*pb.Push_FriendRequestPush)(nil), true)
+	SetCriticalPush((*pb.Push_FriendAcceptPush)(nil), true)
+	SetCriticalPush((*pb.Push_MatchFoundPush)(nil), false) // stale after exit
+
+	// room state is rebuilt by full snapshot after resume
+	SetUnsequencedPush((*pb.Push_RoomSnapshotPush)(nil))
+}
+
+// Register handler for Req oneof type, eg: (*pb.Req_GetUserInfoReq)(nil)
+func RegisterReqHandler(typ interface{}, handler ReqHandler) {
+	t := reflect.TypeOf(typ)
+	if _, ok := reqHandlers[t]; ok {
+		log.Panicln("req handler registered twice:", t)
+	}
+	reqHandlers[t] = handler
+}
+
+// Register handler for Notify oneof type, eg: (*pb.Notify_ChatNotify)(nil)
+func RegisterNotifyHandler(typ interface{}, handler NotifyHandler) {
+	t := reflect.TypeOf(typ)
+	if _, ok := notifyHandlers[t]; ok {
+		log.Panicln("notify handler registered twice:", t)
+	}
+	notifyHandlers[t] = handler
+}
+
+// Dispatch req to handler, unknown req get an error rsp
+func (c *Client) handleReq(req *pb.Req) {
+	handler, ok := reqHandlers[reflect.TypeOf(req.GetReq())]
+	if !ok {
+		c.Send(pb.MakeRsp_Error(req.GetMid(), pb.NewError(pb.ErrorCode_ERR_UNKNOWN_REQUEST, "unknown request")))
+		return
+	}
+	handler(c, req)
+}
+
+// Dispatch notify to handler, unknown notify is dropped
+func (c *Client) handleNotify(ntf *pb.Notify) {
+	handler, ok := notifyHandlers[reflect.TypeOf(ntf.GetNotify())]
+	if !ok {
+		log.Println("unknown notify from uid:", c.uid)
+		return
+	}
+	handler(c, ntf)
+}
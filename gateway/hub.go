@@ -1,32 +1,74 @@
 package main
 
-import "sync"
+import (
+	"context"
+	"sync"
+)
 
 var defaultHub *Hub
 var defaultHubOnce sync.Once
 
 // Hub contains all gateway user clients, use uid as key
 type Hub struct {
+	clientsMu  sync.RWMutex // only run loop write clients, lock for read at other gorotines
 	clients    map[string]*Client
-	broadcast  chan []byte
+	channels   map[string]map[string]*Client // chat channel members, use uid as key
+	broadcast  chan *pushMessage
+	push       chan *userMessage
+	publish    chan *channelMessage
+	join       chan *channelMember
+	leave      chan *channelMember
 	register   chan *Client
 	unregister chan *Client
+
+	alive    map[*Client]bool // registered and not exited, include replaced ones
+	shutdown chan string      // close all clients with reason
+	closing  string           // shutdown reason, new client is closed too
+	drained  chan struct{}    // closed when shutdown and all clients exited
+}
+
+// Message send to one user client
+type userMessage struct {
+	uid  string
+	push *pushMessage
+}
+
+// Message send to chat channel members
+type channelMessage struct {
+	channel string
+	push    *pushMessage
+}
+
+type channelMember struct {
+	channel string
+	client  *Client
 }
 
 func GetHub() *Hub {
 	defaultHubOnce.Do(func() {
 		defaultHub = newHub()
 		go defaultHub.run()
+		go subscribeCluster(defaultHub)
+		go refreshSessions(defaultHub)
 	})
 	return defaultHub
 }
 
 func newHub() *Hub {
 	return &Hub{
-		broadcast:  make(chan []byte),
+		broadcast:  make(chan *pushMessage),
+		push:       make(chan *userMessage),
+		publish:    make(chan *channelMessage),
+		join:       make(chan *channelMember),
+		leave:      make(chan *channelMember),
 		register:   make(chan *Client),
 		unregister: make(chan *Client),
 		clients:    make(map[string]*Client),
+		channels:   make(map[string]map[string]*Client),
+
+		alive:    make(map[*Client]bool),
+		shutdown: make(chan string),
+		drained:  make(chan struct{}),
 	}
 }
 
@@ -34,27 +76,142 @@ func (h *Hub) run() {
 	for {
 		select {
 		case client := <-h.register:
+			if old, ok := h.clients[client.uid]; ok {
+				h.remove(old)
+			}
+			h.clientsMu.Lock()
 			h.clients[client.uid] = client
+			h.clientsMu.Unlock()
+			h.alive[client] = true
+			go notifyFriendPresence(client.uid, client.GetName(), true)
+			if h.closing != "" {
+				go client.ExitWithReason(h.closing)
+			}
 		case client := <-h.unregister:
-			if _, ok := h.clients[client.uid]; ok {
-				delete(h.clients, client.uid)
-				close(client.send)
+			// client may be replaced by new login with same uid, at this or
+			// other gateway
+			if cur, ok := h.clients[client.uid]; ok && cur == client {
+				h.remove(client)
+				if client.ended {
+					go notifyFriendPresence(client.uid, client.GetName(), false)
+				}
 			}
+			delete(h.alive, client)
+			h.checkDrained()
+		case reason := <-h.shutdown:
+			if h.closing != "" {
+				break
+			}
+			h.closing = reason
+			for client := range h.alive {
+				go client.ExitWithReason(reason)
+			}
+			h.checkDrained()
 		case message := <-h.broadcast:
-			for uid, client := range h.clients {
-				select {
-				case client.send <- message:
-				default:
-					close(client.send)
-					delete(h.clients, uid)
-				}
+			for _, client := range h.clients {
+				h.send(client, message)
+			}
+		case message := <-h.push:
+			if client, ok := h.clients[message.uid]; ok {
+				h.send(client, message.push)
+			}
+		case message := <-h.publish:
+			for _, client := range h.channels[message.channel] {
+				h.send(client, message.push)
+			}
+		case member := <-h.join:
+			if cur, ok := h.clients[member.client.uid]; !ok || cur != member.client {
+				break // client exited already
 			}
+			members, ok := h.channels[member.channel]
+			if !ok {
+				members = make(map[string]*Client)
+				h.channels[member.channel] = members
+			}
+			members[member.client.uid] = member.client
+		case member := <-h.leave:
+			h.leaveChannel(member.channel, member.client)
 		}
 	}
 }
 
-// Find hub client by uid
+func (h *Hub) checkDrained() {
+	if h.closing == "" || len(h.alive) > 0 {
+		return
+	}
+	select {
+	case <-h.drained:
+	default:
+		close(h.drained)
+	}
+}
+
+// Close all clients with reason, and wait their exit saving done,
+// ctx error if not all exited before ctx done
+func (h *Hub) Shutdown(ctx context.Context, reason string) error {
+	select {
+	case h.shutdown <- reason:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case <-h.drained:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Send push to client, decoded once for all clients, seq is stamped and
+// encoded at client push pump
+func (h *Hub) send(client *Client, push *pushMessage) {
+	client.Push(push)
+}
+
+// Remove client from hub and all channels, cancel ctx to stop client pumps,
+// send chan is not closed as handler gorotines may still send
+func (h *Hub) remove(client *Client) {
+	if cur, ok := h.clients[client.uid]; !ok || cur != client {
+		return
+	}
+	for channel := range h.channels {
+		h.leaveChannel(channel, client)
+	}
+	h.clientsMu.Lock()
+	delete(h.clients, client.uid)
+	h.clientsMu.Unlock()
+	client.cancel()
+}
+
+func (h *Hub) leaveChannel(channel string, client *Client) {
+	members, ok := h.channels[channel]
+	if !ok {
+		return
+	}
+	if cur, ok := members[client.uid]; ok && cur == client {
+		delete(members, client.uid)
+	}
+	if len(members) == 0 {
+		delete(h.channels, channel)
+	}
+}
+
+// All hub clients, safe at any gorotine
+func (h *Hub) Clients() []*Client {
+	h.clientsMu.RLock()
+	defer h.clientsMu.RUnlock()
+	clients := make([]*Client, 0, len(h.clients))
+	for _, client := range h.clients {
+		clients = append(clients, client)
+	}
+	return clients
+}
+
+// Find hub client by uid, safe at any gorotine
 func (h *Hub) GetClient(uid string) *Client {
+	h.clientsMu.RLock()
+	defer h.clientsMu.RUnlock()
 	client, ok := h.clients[uid]
 	if !ok {
 		return nil
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"game_server/pb"
+)
+
+// handle req, score of self only
+func (c *Client) SubmitScore(req *pb.Req) {
+	submitReq := req.GetSubmitScoreReq()
+	arg := &pb.SubmitScoreArg{
+		Board: submitReq.GetBoard(),
+		Uid:   c.uid,
+		Score: submitReq.GetScore(),
+	}
+	reply, err := GetGameServiceClient().SubmitScore(c.ctx, arg)
+	if err != nil {
+		c.Send(pb.MakeRsp_Error(req.GetMid(), err))
+		return
+	}
+	c.Send(pb.MakeRsp_SubmitScoreRsp(req.GetMid(), reply))
+}
+
+// handle req, top or around self
+func (c *Client) GetLeaderboard(req *pb.Req) {
+	leaderboardReq := req.GetGetLeaderboardReq()
+	arg := &pb.LeaderboardArg{
+		Board:  leaderboardReq.GetBoard(),
+		Uid:    c.uid,
+		Limit:  leaderboardReq.GetLimit(),
+		Around: leaderboardReq.GetAround(),
+	}
+	reply, err := GetGameServiceClient().GetLeaderboard(c.ctx, arg)
+	if err != nil {
+		c.Send(pb.MakeRsp_Error(req.GetMid(), err))
+		return
+	}
+	c.Send(pb.MakeRsp_GetLeaderboardRsp(req.GetMid(), reply))
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"game_server/common"
+	"log"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Rate limit as token bucket, rate is token per second
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// Use oneof wrapper type as key, only write at init
+var rateLimits = make(map[reflect.Type]RateLimit)
+
+// Set rate limit for Req or Notify oneof type, eg: (*pb.Notify_ChatNotify)(nil)
+func SetRateLimit(typ interface{}, limit RateLimit) {
+	rateLimits[reflect.TypeOf(typ)] = limit
+}
+
+// Limit for message type without SetRateLimit, and for abuse, is from config
+func configRateLimit(cfg common.RateLimitConfig) RateLimit {
+	return RateLimit{Rate: cfg.Rate, Burst: cfg.Burst}
+}
+
+// Limit of oneof type, rate_limits by message name first, eg: ChatNotify
+// for (*pb.Notify_ChatNotify)(nil), then SetRateLimit, then rate_limit
+func messageRateLimit(t reflect.Type) RateLimit {
+	cfg := common.GetConfig().Gateway
+	if limit, ok := cfg.RateLimits[messageName(t)]; ok {
+		return configRateLimit(limit)
+	}
+	if limit, ok := rateLimits[t]; ok {
+		return limit
+	}
+	return configRateLimit(cfg.RateLimit)
+}
+
+// Message name of oneof wrapper type, eg: Req_GetUserInfoReq as GetUserInfoReq
+func messageName(t reflect.Type) string {
+	name := t.Elem().Name()
+	return name[strings.Index(name, "_")+1:]
+}
+
+// Log rate_limits of no handled message, e.g. typo in config
+func checkRateLimitNames() {
+	names := make(map[string]bool)
+	for t := range reqHandlers {
+		names[messageName(t)] = true
+	}
+	for t := range notifyHandlers {
+		names[messageName(t)] = true
+	}
+	for name := range common.GetConfig().Gateway.RateLimits {
+		if !names[name] {
+			log.Println("config rate_limits of unknown message:", name)
+		}
+	}
+}
+
+type tokenBucket struct {
+	limit  RateLimit
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(limit RateLimit) *tokenBucket {
+	return &tokenBucket{
+		limit:  limit,
+		tokens: float64(limit.Burst),
+		last:   time.Now(),
+	}
+}
+
+func (b *tokenBucket) allow() bool {
+	now := time.Now()
+	b.tokens += now.Sub(b.last).Seconds() * b.limit.Rate
+	if max := float64(b.limit.Burst); b.tokens > max {
+		b.tokens = max
+	}
+	b.last = now
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// Each client has buckets per message type
+type clientLimiter struct {
+	mu      sync.Mutex
+	buckets map[reflect.Type]*tokenBucket
+	abuse   *tokenBucket
+}
+
+func newClientLimiter() *clientLimiter {
+	return &clientLimiter{
+		buckets: make(map[reflect.Type]*tokenBucket),
+		abuse:   newTokenBucket(configRateLimit(common.GetConfig().Gateway.AbuseRateLimit)),
+	}
+}
+
+// Check message of oneof type, abused is true when client should be kicked
+func (l *clientLimiter) allow(typ interface{}) (ok bool, abused bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	t := reflect.TypeOf(typ)
+	bucket, exists := l.buckets[t]
+	if !exists {
+		bucket = newTokenBucket(messageRateLimit(t))
+		l.buckets[t] = bucket
+	}
+
+	if bucket.allow() {
+		return true, false
+	}
+	return false, !l.abuse.allow()
+}
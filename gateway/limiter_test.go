@@ -0,0 +1,35 @@
+package main
+
+import (
+	"game_server/common"
+	"game_server/pb"
+	"reflect"
+	"testing"
+)
+
+func TestMessageRateLimit(t *testing.T) {
+	cfg := &common.GetConfig().Gateway
+	saved := cfg.RateLimits
+	defer func() { cfg.RateLimits = saved }()
+	cfg.RateLimits = map[string]common.RateLimitConfig{
+		"ChatNotify": {Rate: 3, Burst: 4},
+	}
+
+	chat := reflect.TypeOf((*pb.Notify_ChatNotify)(nil))
+	if name := messageName(chat); name != "ChatNotify" {
+		t.Fatalf("name = %q, want ChatNotify", name)
+	}
+	if limit := messageRateLimit(chat); limit != (RateLimit{Rate: 3, Burst: 4}) {
+		t.Fatalf("chat limit = %+v, want from config", limit)
+	}
+
+	dm := reflect.TypeOf((*pb.Notify_DirectMessageNotify)(nil))
+	if limit := messageRateLimit(dm); limit != rateLimits[dm] {
+		t.Fatalf("dm limit = %+v, want from code", limit)
+	}
+
+	leave := reflect.TypeOf((*pb.Req_LeaveChannelReq)(nil))
+	if limit := messageRateLimit(leave); limit != configRateLimit(cfg.RateLimit) {
+		t.Fatalf("leave limit = %+v, want default", limit)
+	}
+}
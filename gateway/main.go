@@ -1,13 +1,19 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"fmt"
+	"game_server/common"
 	"game_server/model"
+	"game_server/pb"
 	"log"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gorilla/websocket"
+	"gopkg.in/mgo.v2/bson"
 )
 
 var upgrader = websocket.Upgrader{
@@ -16,21 +22,60 @@ var upgrader = websocket.Upgrader{
 	CheckOrigin:     func(r *http.Request) bool { return true },
 }
 
-func init() {
+var dirtyFlusher *flusher
+
+func main() {
+	// setup after flags parsed, not at init, so package can be tested
+	flag.Parse()
+	model.InitStores(common.GetConfig().Storage)
+	checkRateLimitNames()
 	GetHub()
+	dirtyFlusher = newFlusher(common.GetConfig().Gateway.FlushInterval)
 	fmt.Println("Gateway Server Start ...")
-}
 
-func main() {
 	// handle user login
 	http.HandleFunc("/api/login", login)
 	http.HandleFunc("/api/register", register)
+	http.HandleFunc("/api/refresh", refresh)
+	http.HandleFunc("/api/logout", logout)
 	http.HandleFunc("/ws", serveWs)
 
-	err := http.ListenAndServe(":8080", nil)
-	if err != nil {
-		log.Fatalln(err)
+	server := &http.Server{Addr: common.GetConfig().Gateway.Addr}
+	go func() {
+		err := server.ListenAndServe()
+		if err != nil && err != http.ErrServerClosed {
+			log.Fatalln(err)
+		}
+	}()
+
+	common.WaitSignal()
+	fmt.Println("Gateway Server Stopping ...")
+
+	ctx, cancel := context.WithTimeout(context.Background(), common.GetConfig().Gateway.ShutdownTimeout)
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		shutdown(ctx, server)
+		close(done)
+	}()
+	select {
+	case <-done:
+		fmt.Println("Gateway Server Stop ...")
+	case <-ctx.Done():
+		log.Println("gateway shutdown timeout, exit anyway")
+	}
+}
+
+// Stop accepting, close all clients with save, then flush dirty data
+func shutdown(ctx context.Context, server *http.Server) {
+	if err := server.Shutdown(ctx); err != nil {
+		log.Println("http server shutdown failed, err:", err)
+	}
+	if err := GetHub().Shutdown(ctx, "server restarting"); err != nil {
+		log.Println("hub shutdown failed, err:", err)
 	}
+	dirtyFlusher.Stop()
 }
 
 func serveWs(w http.ResponseWriter, r *http.Request) {
@@ -42,30 +87,53 @@ func serveWs(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	sid := r.FormValue("token")
-	if sid == "" {
-		closeWs(conn, "no token")
-		return
-	}
-
-	usr := model.FindUserBySid(sid)
-	if usr == nil {
-		closeWs(conn, "token invaild")
+	usr, err := authUser(r)
+	if err != nil {
+		if _, ok := err.(*pb.Error); !ok {
+			log.Println(err)
+		}
+		closeWs(conn, pb.ToError(err).GetMessage())
 		return
 	}
 
 	uid := usr.GetId()
-	// avoid multiple login
-	client := GetHub().GetClient(uid)
-	if client != nil {
-		client.ExitWithReason("multiple login")
+	// resume client dropped at this gateway, with last seen push seq, sid may
+	// be refreshed since, session claim tell whether client is still current
+	if seq, err := strconv.ParseUint(r.FormValue("seq"), 10, 64); err == nil {
+		if client := GetHub().GetClient(uid); client != nil && client.ownSession() && client.resume(conn, seq) {
+			if room := client.GetRoom(); room != nil {
+				room.Resync(uid)
+			}
+			return
+		}
 	}
 
-	if !model.UserStorageExists(uid) {
-		usr.Storage()
+	// avoid multiple login, at any gateway
+	KickUser(uid, "multiple login")
+
+	// claim before reuse, kicked session exiting later keep storage then
+	owner := bson.NewObjectId().Hex()
+	err = model.ClaimUserSession(uid, owner)
+	exists := false
+	if err == nil {
+		exists, err = model.UserStorageExists(uid)
+	}
+	if err == nil && !exists {
+		err = usr.Storage()
 	}
+	if err != nil {
+		log.Println(err)
+		closeWs(conn, "server error")
+		return
+	}
+
+	client := newClient(conn, GetHub(), uid, owner, usr.GetName())
+
+	// critical pushes not acked at last session
+	deliverPendingPushes(client)
 
-	newClient(conn, GetHub(), uid)
+	// direct messages received while offline
+	deliverInbox(client)
 }
 
 func closeWs(conn *websocket.Conn, reason string) {
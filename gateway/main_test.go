@@ -0,0 +1,12 @@
+package main
+
+import (
+	"game_server/model"
+	"os"
+	"testing"
+)
+
+func TestMain(m *testing.M) {
+	model.InitStores("memory")
+	os.Exit(m.Run())
+}
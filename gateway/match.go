@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"game_server/pb"
+	"log"
+)
+
+// handle req, MatchFoundPush is sent by service when matched
+func (c *Client) JoinMatch(req *pb.Req) {
+	joinReq := req.GetJoinMatchReq()
+	arg := &pb.JoinMatchArg{
+		Uid:       c.uid,
+		Region:    joinReq.GetRegion(),
+		PartySize: joinReq.GetPartySize(),
+	}
+	if _, err := GetGameServiceClient().JoinMatch(c.ctx, arg); err != nil {
+		c.Send(pb.MakeRsp_Error(req.GetMid(), err))
+		return
+	}
+	c.Send(pb.MakeRsp_JoinMatchRsp(req.GetMid()))
+}
+
+// handle req
+func (c *Client) CancelMatch(req *pb.Req) {
+	arg := &pb.String{Value: c.uid}
+	if _, err := GetGameServiceClient().CancelMatch(c.ctx, arg); err != nil {
+		c.Send(pb.MakeRsp_Error(req.GetMid(), err))
+		return
+	}
+	c.Send(pb.MakeRsp_CancelMatchRsp(req.GetMid()))
+}
+
+// Dequeue exited user, so no match with offline user, ctx of client is
+// cancelled at exit, use background with request timeout
+func cancelMatchOnExit(uid string) {
+	_, err := GetGameServiceClient().CancelMatch(context.Background(), &pb.String{Value: uid})
+	if err != nil && pb.ToError(err).GetCode() != pb.ErrorCode_ERR_MATCH_NOT_QUEUED {
+		log.Println("cancel match failed, uid:", uid, "err:", err)
+	}
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"game_server/model"
+	"log"
+	"time"
+)
+
+// Keep user session of hub clients alive, include dropped ones in resume
+// grace, session of crashed gateway expire then
+func refreshSessions(hub *Hub) {
+	ticker := time.NewTicker(model.UserSessionExpire / 3)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		for _, client := range hub.Clients() {
+			ok, err := model.RefreshUserSession(client.uid, client.owner)
+			if err != nil {
+				log.Println("refresh user session failed, uid:", client.uid, "err:", err)
+			} else if !ok {
+				log.Println("user session claimed by new login, uid:", client.uid)
+			}
+		}
+	}
+}
+
+// Session still claimed by client, not by new login at other gateway
+func (c *Client) ownSession() bool {
+	ok, err := model.RefreshUserSession(c.uid, c.owner)
+	if err != nil {
+		log.Println("refresh user session failed, uid:", c.uid, "err:", err)
+	}
+	return ok
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"game_server/pb"
+)
+
+// handle req, public profile by uid, empty as self
+func (c *Client) GetProfile(req *pb.Req) {
+	uid := req.GetGetProfileReq().GetUid()
+	if uid == "" {
+		uid = c.uid
+	}
+
+	arg := &pb.String{Value: uid}
+	reply, err := GetGameServiceClient().GetProfile(c.ctx, arg)
+	if err != nil {
+		c.Send(pb.MakeRsp_Error(req.GetMid(), err))
+		return
+	}
+	c.Send(pb.MakeRsp_GetProfileRsp(req.GetMid(), reply))
+}
+
+// handle req, display name used by chat etc.. changed at once
+func (c *Client) UpdateProfile(req *pb.Req) {
+	updateReq := req.GetUpdateProfileReq()
+	arg := &pb.UpdateProfileArg{
+		Uid:      c.uid,
+		Nickname: updateReq.GetNickname(),
+		Avatar:   updateReq.GetAvatar(),
+		Bio:      updateReq.GetBio(),
+	}
+	reply, err := GetGameServiceClient().UpdateProfile(c.ctx, arg)
+	if err != nil {
+		c.Send(pb.MakeRsp_Error(req.GetMid(), err))
+		return
+	}
+
+	c.SetName(reply.GetName())
+	c.Send(pb.MakeRsp_UpdateProfileRsp(req.GetMid(), reply))
+}
@@ -0,0 +1,449 @@
+package main
+
+import (
+	"game_server/common"
+	"game_server/pb"
+	"log"
+	"sync"
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+)
+
+// Room is local to gateway, members must connect to same gateway,
+// each room own a tick gorotine, apply inputs and push delta snapshots
+// to members through hub, room is removed when all members left.
+// Players of one match may connect to different gateways, they can not
+// share a room, balancer should route players of match to same gateway
+
+const (
+	roomWorldSize = 100 // x and y between 0-100
+	roomMoveSpeed = 10  // units per second
+)
+
+var defaultRoomManager *RoomManager
+var defaultRoomManagerOnce sync.Once
+
+type RoomManager struct {
+	mu    sync.Mutex
+	rooms map[string]*Room
+}
+
+func GetRoomManager() *RoomManager {
+	defaultRoomManagerOnce.Do(func() {
+		defaultRoomManager = &RoomManager{
+			rooms: make(map[string]*Room),
+		}
+	})
+	return defaultRoomManager
+}
+
+// New room with tick gorotine started, empty until creator join
+func (m *RoomManager) Create(maxPlayers int) *Room {
+	room := newRoom(bson.NewObjectId().Hex(), maxPlayers, GetHub())
+
+	m.mu.Lock()
+	m.rooms[room.id] = room
+	m.mu.Unlock()
+
+	go room.run(common.GetConfig().Gateway.RoomTick)
+	return room
+}
+
+func (m *RoomManager) Get(id string) *Room {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.rooms[id]
+}
+
+func (m *RoomManager) remove(id string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.rooms, id)
+}
+
+type Room struct {
+	id         string
+	maxPlayers int
+	hub        *Hub
+
+	mu      sync.Mutex
+	players map[string]*roomPlayer // use uid as key
+	started bool
+	closed  bool
+	tick    uint64
+	sent    map[string]roomPlayerState // state at last snapshot, for delta
+	removed []string                   // left since last snapshot
+	stop    chan struct{}
+}
+
+type roomPlayer struct {
+	uid      string
+	name     string
+	ready    bool
+	needFull bool // joined, full snapshot at next tick
+	state    roomPlayerState
+	moveX    int32 // latest input
+	moveY    int32
+}
+
+type roomPlayerState struct {
+	x   float32
+	y   float32
+	seq uint32
+}
+
+func newRoom(id string, maxPlayers int, hub *Hub) *Room {
+	return &Room{
+		id:         id,
+		maxPlayers: maxPlayers,
+		hub:        hub,
+		players:    make(map[string]*roomPlayer),
+		sent:       make(map[string]roomPlayerState),
+		stop:       make(chan struct{}),
+	}
+}
+
+func (r *Room) run(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.update(interval)
+		case <-r.stop:
+			return
+		}
+	}
+}
+
+// Advance one tick, then push delta to members and full to new members
+func (r *Room) update(dt time.Duration) {
+	r.mu.Lock()
+	r.tick++
+
+	if r.started {
+		step := float32(roomMoveSpeed * dt.Seconds())
+		for _, p := range r.players {
+			p.state.x = clampRoomPosition(p.state.x + float32(p.moveX)*step)
+			p.state.y = clampRoomPosition(p.state.y + float32(p.moveY)*step)
+		}
+	}
+
+	delta := &pb.RoomSnapshotPush{RoomId: r.id, Tick: r.tick, Removed: r.removed}
+	full := &pb.RoomSnapshotPush{RoomId: r.id, Tick: r.tick, Full: true}
+	r.removed = nil
+
+	deltaUids := []string{}
+	fullUids := []string{}
+	for uid, p := range r.players {
+		state := p.state.toPb(uid)
+		full.Players = append(full.Players, state)
+		if sent, ok := r.sent[uid]; !ok || sent != p.state {
+			delta.Players = append(delta.Players, state)
+			r.sent[uid] = p.state
+		}
+
+		if p.needFull {
+			p.needFull = false
+			fullUids = append(fullUids, uid)
+		} else {
+			deltaUids = append(deltaUids, uid)
+		}
+	}
+	r.mu.Unlock()
+
+	if len(delta.Players) > 0 || len(delta.Removed) > 0 {
+		r.push(deltaUids, pb.MakePush_RoomSnapshotPush(delta))
+	}
+	r.push(fullUids, pb.MakePush_RoomSnapshotPush(full))
+}
+
+func clampRoomPosition(v float32) float32 {
+	if v < 0 {
+		return 0
+	}
+	if v > roomWorldSize {
+		return roomWorldSize
+	}
+	return v
+}
+
+func (s roomPlayerState) toPb(uid string) *pb.RoomPlayerState {
+	return &pb.RoomPlayerState{Uid: uid, X: s.x, Y: s.y, Seq: s.seq}
+}
+
+// Push to members through hub, never call with r.mu locked
+func (r *Room) push(uids []string, msg *pb.Message) {
+	if len(uids) == 0 {
+		return
+	}
+	push, err := newPushMessage(msg)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	for _, uid := range uids {
+		r.hub.push <- &userMessage{uid: uid, push: push}
+	}
+}
+
+func (r *Room) Join(c *Client) (*pb.RoomInfo, error) {
+	r.mu.Lock()
+	if r.closed {
+		r.mu.Unlock()
+		return nil, pb.NewError(pb.ErrorCode_ERR_ROOM_NOT_FOUND, "room not found")
+	}
+	if r.started {
+		r.mu.Unlock()
+		return nil, pb.NewError(pb.ErrorCode_ERR_ROOM_STARTED, "room started")
+	}
+	if len(r.players) >= r.maxPlayers {
+		r.mu.Unlock()
+		return nil, pb.NewError(pb.ErrorCode_ERR_ROOM_FULL, "room full")
+	}
+
+	// spawn along x by join order
+	n := float32(len(r.players) + 1)
+	r.players[c.uid] = &roomPlayer{
+		uid:      c.uid,
+		name:     c.GetName(),
+		needFull: true,
+		state:    roomPlayerState{x: roomWorldSize * n / float32(r.maxPlayers+1), y: roomWorldSize / 2},
+	}
+	info, uids := r.infoLocked()
+	r.mu.Unlock()
+
+	r.push(uids, pb.MakePush_RoomUpdatePush(info))
+	return info, nil
+}
+
+// Room is closed and removed when last member left
+func (r *Room) Leave(uid string) {
+	r.mu.Lock()
+	if _, ok := r.players[uid]; !ok {
+		r.mu.Unlock()
+		return
+	}
+	delete(r.players, uid)
+	delete(r.sent, uid)
+	r.removed = append(r.removed, uid)
+
+	if len(r.players) == 0 {
+		r.closeLocked()
+		r.mu.Unlock()
+		GetRoomManager().remove(r.id)
+		return
+	}
+	info, uids := r.infoLocked()
+	r.mu.Unlock()
+
+	r.push(uids, pb.MakePush_RoomUpdatePush(info))
+}
+
+// Close and remove room never joined, e.g. creator join failed
+func (r *Room) CloseEmpty() {
+	r.mu.Lock()
+	if len(r.players) > 0 || r.closed {
+		r.mu.Unlock()
+		return
+	}
+	r.closeLocked()
+	r.mu.Unlock()
+	GetRoomManager().remove(r.id)
+}
+
+// Stop tick gorotine, r.mu must be locked
+func (r *Room) closeLocked() {
+	r.closed = true
+	close(r.stop)
+}
+
+// Start game when all members ready, at least 2 members
+func (r *Room) Ready(uid string, ready bool) error {
+	r.mu.Lock()
+	p, ok := r.players[uid]
+	if !ok {
+		r.mu.Unlock()
+		return pb.NewError(pb.ErrorCode_ERR_ROOM_NOT_JOINED, "room not joined")
+	}
+	if r.started {
+		r.mu.Unlock()
+		return pb.NewError(pb.ErrorCode_ERR_ROOM_STARTED, "room started")
+	}
+	p.ready = ready
+
+	if len(r.players) >= 2 {
+		r.started = true
+		for _, p := range r.players {
+			r.started = r.started && p.ready
+		}
+	}
+	info, uids := r.infoLocked()
+	r.mu.Unlock()
+
+	r.push(uids, pb.MakePush_RoomUpdatePush(info))
+	return nil
+}
+
+// Full snapshot at next tick, e.g. member resumed and missed deltas
+func (r *Room) Resync(uid string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if p, ok := r.players[uid]; ok {
+		p.needFull = true
+	}
+}
+
+// Keep latest input, applied at next ticks
+func (r *Room) Input(uid string, input *pb.RoomInputNotify) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p, ok := r.players[uid]
+	if !ok || input.GetSeq() <= p.state.seq {
+		return // left, or out of order input
+	}
+	p.moveX = clampRoomMove(input.GetMoveX())
+	p.moveY = clampRoomMove(input.GetMoveY())
+	p.state.seq = input.GetSeq()
+}
+
+func clampRoomMove(v int32) int32 {
+	if v < 0 {
+		return -1
+	}
+	if v > 0 {
+		return 1
+	}
+	return 0
+}
+
+// Room info and member uids, r.mu must be locked
+func (r *Room) infoLocked() (*pb.RoomInfo, []string) {
+	info := &pb.RoomInfo{
+		RoomId:     r.id,
+		MaxPlayers: int32(r.maxPlayers),
+		Started:    r.started,
+	}
+	uids := []string{}
+	for uid, p := range r.players {
+		info.Members = append(info.Members, &pb.RoomMember{Uid: uid, Name: p.name, Ready: p.ready})
+		uids = append(uids, uid)
+	}
+	return info, uids
+}
+
+func (c *Client) GetRoom() *Room {
+	c.roomMu.Lock()
+	defer c.roomMu.Unlock()
+	return c.room
+}
+
+// Leave room on exit, so room is cleaned when all exited
+func (c *Client) leaveRoom() {
+	c.roomMu.Lock()
+	defer c.roomMu.Unlock()
+
+	if c.room != nil {
+		c.room.Leave(c.uid)
+		c.leaveChannel(roomChannelPrefix + c.room.id)
+		c.room = nil
+	}
+}
+
+// handle req, creator join at once
+func (c *Client) CreateRoom(req *pb.Req) {
+	c.roomMu.Lock()
+	defer c.roomMu.Unlock()
+
+	if c.room != nil {
+		c.Send(pb.MakeRsp_Error(req.GetMid(), pb.NewError(pb.ErrorCode_ERR_ROOM_JOINED, "room joined already")))
+		return
+	}
+
+	maxPlayers := int(req.GetCreateRoomReq().GetMaxPlayers())
+	if limit := common.GetConfig().Gateway.RoomMaxPlayers; maxPlayers <= 0 || maxPlayers > limit {
+		maxPlayers = limit
+	} else if maxPlayers < 2 {
+		maxPlayers = 2
+	}
+
+	room := GetRoomManager().Create(maxPlayers)
+	info, err := room.Join(c)
+	if err != nil {
+		room.CloseEmpty()
+		c.Send(pb.MakeRsp_Error(req.GetMid(), err))
+		return
+	}
+	c.room = room
+
+	c.Send(pb.MakeRsp_CreateRoomRsp(req.GetMid(), info))
+}
+
+// handle req, room must be at same gateway
+func (c *Client) JoinRoom(req *pb.Req) {
+	c.roomMu.Lock()
+	defer c.roomMu.Unlock()
+
+	if c.room != nil {
+		c.Send(pb.MakeRsp_Error(req.GetMid(), pb.NewError(pb.ErrorCode_ERR_ROOM_JOINED, "room joined already")))
+		return
+	}
+
+	room := GetRoomManager().Get(req.GetJoinRoomReq().GetRoomId())
+	if room == nil {
+		c.Send(pb.MakeRsp_Error(req.GetMid(), pb.NewError(pb.ErrorCode_ERR_ROOM_NOT_FOUND, "room not found")))
+		return
+	}
+	info, err := room.Join(c)
+	if err != nil {
+		c.Send(pb.MakeRsp_Error(req.GetMid(), err))
+		return
+	}
+	c.room = room
+
+	c.Send(pb.MakeRsp_JoinRoomRsp(req.GetMid(), info))
+}
+
+// handle req
+func (c *Client) LeaveRoom(req *pb.Req) {
+	c.roomMu.Lock()
+	defer c.roomMu.Unlock()
+
+	if c.room == nil {
+		c.Send(pb.MakeRsp_Error(req.GetMid(), pb.NewError(pb.ErrorCode_ERR_ROOM_NOT_JOINED, "room not joined")))
+		return
+	}
+	roomId := c.room.id
+	c.room.Leave(c.uid)
+	c.leaveChannel(roomChannelPrefix + roomId)
+	c.room = nil
+
+	c.Send(pb.MakeRsp_LeaveRoomRsp(req.GetMid(), roomId))
+}
+
+// handle req
+func (c *Client) ReadyRoom(req *pb.Req) {
+	room := c.GetRoom()
+	if room == nil {
+		c.Send(pb.MakeRsp_Error(req.GetMid(), pb.NewError(pb.ErrorCode_ERR_ROOM_NOT_JOINED, "room not joined")))
+		return
+	}
+
+	ready := req.GetReadyRoomReq().GetReady()
+	if err := room.Ready(c.uid, ready); err != nil {
+		c.Send(pb.MakeRsp_Error(req.GetMid(), err))
+		return
+	}
+	c.Send(pb.MakeRsp_ReadyRoomRsp(req.GetMid(), ready))
+}
+
+// handle notify, dropped if not in room
+func (c *Client) RoomInput(ntf *pb.Notify) {
+	if room := c.GetRoom(); room != nil {
+		room.Input(c.uid, ntf.GetRoomInputNotify())
+	}
+}
@@ -0,0 +1,22 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestRoomCloseEmpty(t *testing.T) {
+	room := GetRoomManager().Create(0)
+	if _, err := room.Join(newTestClient("u1")); err == nil {
+		t.Fatal("joined room of no seat")
+	}
+	room.CloseEmpty()
+
+	if GetRoomManager().Get(room.id) != nil {
+		t.Fatal("room kept after close")
+	}
+	select {
+	case <-room.stop:
+	default:
+		t.Fatal("room tick not stopped")
+	}
+}
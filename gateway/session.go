@@ -0,0 +1,358 @@
+package main
+
+import (
+	"errors"
+	"game_server/common"
+	"game_server/model"
+	"game_server/pb"
+	"log"
+	"reflect"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/gorilla/websocket"
+)
+
+// Client session outlive its conn, when conn dropped, client is kept in hub
+// for resume grace, pushes are still sequenced and kept, reconnect of same
+// user with last seen seq attach new conn and replay pushes after it.
+// Critical pushes of client ever acked are kept until acked, redelivered if
+// not acked in time, and saved as pending at exit, delivered at next login
+
+// Send queue besides room for replay
+const sendBufferSize = 64
+
+type seqPush struct {
+	seq     uint64
+	data    []byte
+	sentAt  time.Time // last queued, for redelivery
+	persist bool      // saved as pending at exit if not acked
+}
+
+// Push types not sequenced and not replayed, only write at init
+var unsequencedPushes = make(map[reflect.Type]bool)
+
+// Set Push oneof type as not replayed, eg: (*pb.Push_RoomSnapshotPush)(nil),
+// for frequent pushes whose state is rebuilt after resume
+func SetUnsequencedPush(typ interface{}) {
+	unsequencedPushes[reflect.TypeOf(typ)] = true
+}
+
+// Push types kept until acked, value as persist, only write at init
+var criticalPushes = make(map[reflect.Type]bool)
+
+// Set Push oneof type as critical, eg: (*pb.Push_SystemPush)(nil), persist
+// false if push is stale after session ended, e.g. match found
+func SetCriticalPush(typ interface{}, persist bool) {
+	criticalPushes[reflect.TypeOf(typ)] = persist
+}
+
+// Key of pb.Message push field, number 4, length delimited
+const messagePushKey = 4<<3 | proto.WireBytes
+
+// Push encoded once for all recipients, seq is stamped per client
+type pushMessage struct {
+	typ  reflect.Type // oneof type of push
+	body []byte       // encoded pb.Push without seq
+}
+
+func newPushMessage(msg *pb.Message) (*pushMessage, error) {
+	push := msg.GetPush()
+	if push == nil {
+		return nil, errors.New("not push message")
+	}
+	body, err := proto.Marshal(&pb.Push{Push: push.GetPush()})
+	if err != nil {
+		return nil, err
+	}
+	return &pushMessage{typ: reflect.TypeOf(push.GetPush()), body: body}, nil
+}
+
+// Decode encoded push message once, e.g. from cluster
+func decodePushMessage(data []byte) (*pushMessage, error) {
+	msg := &pb.Message{}
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return nil, err
+	}
+	return newPushMessage(msg)
+}
+
+// Encoded pb.Message with seq, fields of encoded message are merged when
+// decoded, so seq field is put before shared body, never encode push again
+func (p *pushMessage) encode(seq uint64) ([]byte, error) {
+	push := p.body
+	if seq > 0 {
+		data, err := proto.Marshal(&pb.Push{Seq: seq})
+		if err != nil {
+			return nil, err
+		}
+		push = append(data, p.body...)
+	}
+	buf := proto.NewBuffer(make([]byte, 0, len(push)+16))
+	buf.EncodeVarint(messagePushKey)
+	buf.EncodeRawBytes(push)
+	return buf.Bytes(), nil
+}
+
+// Start pumps of new conn with its own queue, c.connMu must be locked
+func (c *Client) attachLocked(conn *websocket.Conn) {
+	c.conn = conn
+	c.send = make(chan []byte, common.GetConfig().Gateway.ResumeBuffer+sendBufferSize)
+	c.connStop = make(chan struct{})
+
+	go c.readPump(conn, c.connStop)
+	go c.writePump(conn, c.send, c.connStop)
+}
+
+// Close conn and stop its pumps, c.connMu must be locked
+func (c *Client) closeConnLocked() {
+	if c.conn == nil {
+		return
+	}
+	c.conn.Close()
+	close(c.connStop)
+	c.conn = nil
+}
+
+// Conn attached and not dropped
+func (c *Client) Connected() bool {
+	c.connMu.Lock()
+	defer c.connMu.Unlock()
+	return c.conn != nil
+}
+
+// Drop conn if it is still current one
+func (c *Client) drop(conn *websocket.Conn) {
+	c.connMu.Lock()
+	defer c.connMu.Unlock()
+
+	if c.conn == conn {
+		c.dropLocked()
+	}
+}
+
+// Close conn and exit if not resumed in grace, c.connMu must be locked
+func (c *Client) dropLocked() {
+	if c.conn == nil || c.exited {
+		return
+	}
+	c.closeConnLocked()
+
+	grace := common.GetConfig().Gateway.ResumeGrace
+	if grace <= 0 {
+		go c.Exit()
+		return
+	}
+	c.grace = time.AfterFunc(grace, c.Exit)
+}
+
+// Queue data to write pump, drop conn if it can not receive, c.connMu must be locked
+func (c *Client) queueLocked(data []byte) {
+	if c.conn == nil {
+		return
+	}
+	select {
+	case c.send <- data:
+	default:
+		c.dropLocked()
+	}
+}
+
+// Queue push from hub, sequenced and encoded at push pump, so hub never
+// wait encoding of each client
+func (c *Client) Push(push *pushMessage) {
+	select {
+	case c.pushQueue <- push:
+	default:
+		log.Println("too many pushes queued, drop push, uid:", c.uid)
+	}
+}
+
+// Sequence pushes from hub in hub order, until client exit
+func (c *Client) pushPump() {
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case push := <-c.pushQueue:
+			c.sendPush(push)
+		}
+	}
+}
+
+// Set seq of push, keep it for replay, and queue if connected
+func (c *Client) sendPush(push *pushMessage) {
+	c.connMu.Lock()
+	defer c.connMu.Unlock()
+
+	if c.exited {
+		return
+	}
+	if unsequencedPushes[push.typ] {
+		data, err := push.encode(0)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+		c.queueLocked(data)
+		return
+	}
+
+	c.pushSeq++
+	data, err := push.encode(c.pushSeq)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	limit := common.GetConfig().Gateway.ResumeBuffer
+	c.pushes = append(c.pushes, seqPush{seq: c.pushSeq, data: data})
+	if len(c.pushes) > limit {
+		c.pushes = c.pushes[len(c.pushes)-limit:]
+	}
+	// client never acked keep nothing, as pushes would never be acked
+	if persist, ok := criticalPushes[push.typ]; ok && c.acking {
+		c.unacked = append(c.unacked, &seqPush{seq: c.pushSeq, data: data, sentAt: time.Now(), persist: persist})
+		if len(c.unacked) > limit {
+			log.Println("too many pushes not acked, drop oldest, uid:", c.uid)
+			c.unacked = c.unacked[1:]
+		}
+	}
+	c.queueLocked(data)
+}
+
+// handle notify, pushes up to seq are not replayed or redelivered
+func (c *Client) Ack(ntf *pb.Notify) {
+	c.connMu.Lock()
+	defer c.connMu.Unlock()
+
+	c.acking = true
+	c.ackLocked(ntf.GetAckNotify().GetSeq())
+}
+
+// Forget pushes up to seq, c.connMu must be locked
+func (c *Client) ackLocked(seq uint64) {
+	if seq > c.pushSeq {
+		return // seq from other session
+	}
+	n := 0
+	for n < len(c.unacked) && c.unacked[n].seq <= seq {
+		n++
+	}
+	c.unacked = c.unacked[n:]
+
+	n = 0
+	for n < len(c.pushes) && c.pushes[n].seq <= seq {
+		n++
+	}
+	c.pushes = c.pushes[n:]
+}
+
+// Queue critical pushes not acked in timeout again, only for client ever
+// acked, as ack is optional
+func (c *Client) redeliver(timeout time.Duration) {
+	c.connMu.Lock()
+	defer c.connMu.Unlock()
+
+	if !c.acking {
+		return
+	}
+	now := time.Now()
+	for _, push := range c.unacked {
+		if now.Sub(push.sentAt) >= timeout {
+			push.sentAt = now
+			c.queueLocked(push.data)
+		}
+	}
+}
+
+// Critical pushes not acked to save as pending at exit, none if client
+// never acked, as all pushes would be delivered again at each login
+func (c *Client) pendingPushes() [][]byte {
+	c.connMu.Lock()
+	defer c.connMu.Unlock()
+
+	if !c.acking {
+		return nil
+	}
+	pushes := make([][]byte, 0, len(c.unacked))
+	for _, push := range c.unacked {
+		if push.persist {
+			pushes = append(pushes, push.data)
+		}
+	}
+	return pushes
+}
+
+// Critical pushes not acked at last session, sequenced again
+func deliverPendingPushes(c *Client) {
+	pushes, err := model.TakePendingPushes(c.uid)
+	if err != nil {
+		log.Println("take pending pushes failed, uid:", c.uid, "err:", err)
+		return
+	}
+	for _, data := range pushes {
+		push, err := decodePushMessage(data)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		c.sendPush(push)
+	}
+}
+
+// Not sequenced, tell client whether session resumed
+func (c *Client) sessionPush(resumed, lost bool) []byte {
+	data, err := proto.Marshal(pb.MakePush_SessionPush(&pb.SessionPush{
+		Resumed: resumed,
+		Lost:    lost,
+		Seq:     c.pushSeq,
+	}))
+	if err != nil {
+		log.Println(err)
+	}
+	return data
+}
+
+// Attach new conn, old one is closed if not dropped yet, and replay pushes
+// after lastSeq as acked, false if client exited
+func (c *Client) resume(conn *websocket.Conn, lastSeq uint64) bool {
+	c.connMu.Lock()
+	defer c.connMu.Unlock()
+
+	if c.exited {
+		return false
+	}
+	if c.grace != nil {
+		c.grace.Stop()
+		c.grace = nil
+	}
+	// unsent data in queue of old conn is dropped, pushes are replayed below
+	c.closeConnLocked()
+
+	// client seq from other session, or pushes after it not kept
+	oldest := c.pushSeq + 1
+	if len(c.pushes) > 0 {
+		oldest = c.pushes[0].seq
+	}
+	lost := lastSeq > c.pushSeq || lastSeq+1 < oldest
+	c.ackLocked(lastSeq)
+
+	c.attachLocked(conn)
+	c.queueLocked(c.sessionPush(true, lost))
+	// critical pushes not kept for replay, then recent ones
+	now := time.Now()
+	for _, push := range c.unacked {
+		push.sentAt = now
+		if push.seq < oldest {
+			c.queueLocked(push.data)
+		}
+	}
+	for _, push := range c.pushes {
+		if push.seq > lastSeq {
+			c.queueLocked(push.data)
+		}
+	}
+	return true
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"game_server/pb"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Client without conn, pushes are only sequenced and kept
+func newTestClient(uid string) *Client {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Client{ctx: ctx, cancel: cancel, uid: uid, send: make(chan []byte, 16), pushQueue: make(chan *pushMessage, 16)}
+}
+
+func TestPendingPushes(t *testing.T) {
+	c := newTestClient("u1")
+	c.Send(pb.MakePush_SystemPush(&pb.SystemPush{}))
+
+	// client never acked keep no critical push
+	if pushes := c.pendingPushes(); len(pushes) != 0 || len(c.unacked) != 0 {
+		t.Fatalf("pending = %d, unacked = %d before ack, want 0", len(pushes), len(c.unacked))
+	}
+
+	c.Ack(&pb.Notify{Notify: &pb.Notify_AckNotify{AckNotify: &pb.AckNotify{Seq: 0}}})
+	c.Send(pb.MakePush_SystemPush(&pb.SystemPush{}))
+	c.Send(pb.MakePush_MatchFoundPush(&pb.MatchFoundPush{}))
+	c.Send(pb.MakePush_ChatPush(&pb.ChatPush{}))
+	if pushes := c.pendingPushes(); len(pushes) != 1 {
+		t.Fatalf("pending = %d after ack, want system push only", len(pushes))
+	}
+
+	c.Ack(&pb.Notify{Notify: &pb.Notify_AckNotify{AckNotify: &pb.AckNotify{Seq: 4}}})
+	if pushes := c.pendingPushes(); len(pushes) != 0 {
+		t.Fatalf("pending = %d after all acked, want 0", len(pushes))
+	}
+}
+
+func TestSnapshotNotReplayed(t *testing.T) {
+	c := newTestClient("u1")
+	c.Send(pb.MakePush_ChatPush(&pb.ChatPush{}))
+	for i := 0; i < 1000; i++ {
+		c.Send(pb.MakePush_RoomSnapshotPush(&pb.RoomSnapshotPush{}))
+	}
+	c.Send(pb.MakePush_ChatPush(&pb.ChatPush{}))
+
+	if c.pushSeq != 2 || len(c.pushes) != 2 {
+		t.Fatalf("seq = %d, kept = %d, want chats only", c.pushSeq, len(c.pushes))
+	}
+}
+
+func TestPushMessageEncode(t *testing.T) {
+	push, err := newPushMessage(pb.MakePush_SystemPush(&pb.SystemPush{Message: "hello"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, seq := range []uint64{0, 1, 300} {
+		data, err := push.encode(seq)
+		if err != nil {
+			t.Fatal(err)
+		}
+		msg := &pb.Message{}
+		if err := proto.Unmarshal(data, msg); err != nil {
+			t.Fatal(err)
+		}
+		if msg.GetPush().GetSeq() != seq || msg.GetPush().GetSystemPush().GetMessage() != "hello" {
+			t.Fatalf("decoded = %v, want seq %d with system push", msg, seq)
+		}
+	}
+}
@@ -1,8 +1,11 @@
 package main
 
 import (
+	"context"
+	"game_server/common"
 	"game_server/pb"
 	"log"
+	"path"
 	"sync"
 
 	"google.golang.org/grpc"
@@ -13,7 +16,7 @@ var defaultGameServiceClientOnce sync.Once
 
 func GetGameServiceClient() pb.GameServiceClient {
 	defaultGameServiceClientOnce.Do(func() {
-		conn, err := grpc.Dial(":1234", grpc.WithInsecure())
+		conn, err := grpc.Dial(common.GetConfig().Service.Addr, grpc.WithInsecure(), grpc.WithUnaryInterceptor(timeoutInterceptor))
 
 		if err != nil {
 			log.Println(err)
@@ -24,3 +27,19 @@ func GetGameServiceClient() pb.GameServiceClient {
 
 	return defaultGameServiceClient
 }
+
+// Deadline by method from config, eg: "/pb.GameService/GetUserInfo" use
+// request_timeouts GetUserInfo, or request_timeout, cancel with ctx of caller
+func timeoutInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	cfg := common.GetConfig().Gateway
+	timeout, ok := cfg.RequestTimeouts[path.Base(method)]
+	if !ok {
+		timeout = cfg.RequestTimeout
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
@@ -3,17 +3,17 @@ package main
 import (
 	"crypto/rand"
 	"encoding/base64"
-	"errors"
+	"game_server/pb"
 	"regexp"
 )
 
 func ValidateEmail(email string) error {
 	if email == "" {
-		return errors.New("email empty")
+		return pb.NewError(pb.ErrorCode_ERR_EMAIL_INVALID, "email empty")
 	}
 
 	if m, _ := regexp.MatchString(`^([\w\.\_]{2,10})@(\w{1,})\.([a-z]{2,4})$`, email); !m {
-		return errors.New("email invalid")
+		return pb.NewError(pb.ErrorCode_ERR_EMAIL_INVALID, "email invalid")
 	}
 
 	return nil
@@ -21,7 +21,7 @@ func ValidateEmail(email string) error {
 
 func ValidatePassword(password string) error {
 	if len := len(password); len < 6 || len > 18 {
-		return errors.New("password length between 6-18")
+		return pb.NewError(pb.ErrorCode_ERR_PASSWORD_INVALID, "password length between 6-18")
 	}
 
 	return nil
@@ -0,0 +1,46 @@
+package model
+
+import (
+	"sort"
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+)
+
+// Chat message history, private chat use sorted uid pair as channel
+
+type ChatMessage struct {
+	Id        bson.ObjectId `bson:"_id" json:"id"` // increase by time, as cursor
+	Channel   string        `bson:"channel" json:"channel"`
+	Uid       string        `bson:"uid" json:"uid"`
+	Name      string        `bson:"name" json:"name"`
+	Message   string        `bson:"message" json:"message"`
+	CreatedAt time.Time     `bson:"created_at" json:"created_at"`
+}
+
+// Channel of private chat history between two users
+func PrivateChatChannel(uid1, uid2 string) string {
+	uids := []string{uid1, uid2}
+	sort.Strings(uids)
+	return "private:" + uids[0] + ":" + uids[1]
+}
+
+// Create chat message into mgo
+func CreateChatMessage(msg *ChatMessage) error {
+	return chatStore.CreateChatMessage(msg)
+}
+
+// Find latest chat messages before cursor, empty cursor as latest,
+// return in time order
+func FindChatMessages(channel, before string, limit int) ([]*ChatMessage, error) {
+	msgs, err := chatStore.FindChatMessages(channel, before, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, j := 0, len(msgs)-1; i < j; i, j = i+1, j-1 {
+		msgs[i], msgs[j] = msgs[j], msgs[i]
+	}
+
+	return msgs, nil
+}
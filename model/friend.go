@@ -0,0 +1,90 @@
+package model
+
+import (
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+)
+
+// Friendship is saved as two rows, one for each side,
+// request is removed after accept or decline
+
+type Friend struct {
+	Id        bson.ObjectId `bson:"_id,omitempty" json:"id"`
+	Uid       string        `bson:"uid" json:"uid"`
+	FriendUid string        `bson:"friend_uid" json:"friend_uid"`
+	CreatedAt time.Time     `bson:"created_at" json:"created_at"`
+}
+
+type FriendRequest struct {
+	Id        bson.ObjectId `bson:"_id,omitempty" json:"id"`
+	From      string        `bson:"from" json:"from"`
+	To        string        `bson:"to" json:"to"`
+	CreatedAt time.Time     `bson:"created_at" json:"created_at"`
+}
+
+// Friends of user, in added order
+func FindFriends(uid string) ([]*Friend, error) {
+	return friendStore.FindFriends(uid)
+}
+
+func IsFriend(uid, friendUid string) (bool, error) {
+	_, err := friendStore.FindFriend(uid, friendUid)
+	if err == ErrNotFound {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// Requests received by user, not accepted or declined yet
+func FindFriendRequests(to string) ([]*FriendRequest, error) {
+	return friendStore.FindFriendRequests(to)
+}
+
+// Create request into mgo, ErrDuplicate if sent before
+func CreateFriendRequest(from, to string) error {
+	return friendStore.CreateFriendRequest(&FriendRequest{
+		Id:        bson.NewObjectId(),
+		From:      from,
+		To:        to,
+		CreatedAt: time.Now(),
+	})
+}
+
+// Remove request and make friends both sides, ErrNotFound if no request
+func AcceptFriendRequest(uid, from string) error {
+	if err := friendStore.DeleteFriendRequest(from, uid); err != nil {
+		return err
+	}
+	// request to each other, remove the other one too
+	if err := friendStore.DeleteFriendRequest(uid, from); err != nil && err != ErrNotFound {
+		return err
+	}
+
+	now := time.Now()
+	for _, f := range []*Friend{
+		{Id: bson.NewObjectId(), Uid: uid, FriendUid: from, CreatedAt: now},
+		{Id: bson.NewObjectId(), Uid: from, FriendUid: uid, CreatedAt: now},
+	} {
+		if err := friendStore.CreateFriend(f); err != nil && err != ErrDuplicate {
+			return err
+		}
+	}
+	return nil
+}
+
+// Remove request, ErrNotFound if no request
+func DeclineFriendRequest(uid, from string) error {
+	return friendStore.DeleteFriendRequest(from, uid)
+}
+
+// Remove friendship both sides, ErrNotFound if not friends
+func RemoveFriend(uid, friendUid string) error {
+	if err := friendStore.DeleteFriend(uid, friendUid); err != nil {
+		return err
+	}
+	if err := friendStore.DeleteFriend(friendUid, uid); err != nil && err != ErrNotFound {
+		return err
+	}
+	return nil
+}
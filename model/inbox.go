@@ -0,0 +1,33 @@
+package model
+
+import (
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+)
+
+// Direct messages to offline user, removed after delivered
+
+type InboxMessage struct {
+	Id        bson.ObjectId `bson:"_id" json:"id"` // same as push id
+	To        string        `bson:"to" json:"to"`
+	Uid       string        `bson:"uid" json:"uid"` // sender
+	Name      string        `bson:"name" json:"name"`
+	Message   string        `bson:"message" json:"message"`
+	CreatedAt time.Time     `bson:"created_at" json:"created_at"`
+}
+
+// Create message into user inbox
+func CreateInboxMessage(msg *InboxMessage) error {
+	return inboxStore.CreateInboxMessage(msg)
+}
+
+// Find oldest inbox messages of user, in time order
+func FindInboxMessages(to string, limit int) ([]*InboxMessage, error) {
+	return inboxStore.FindInboxMessages(to, limit)
+}
+
+// Remove delivered messages, id not greater than last
+func DeleteInboxMessages(to string, last bson.ObjectId) error {
+	return inboxStore.DeleteInboxMessages(to, last)
+}
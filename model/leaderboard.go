@@ -0,0 +1,109 @@
+package model
+
+import (
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+)
+
+// Leaderboard is redis sorted set per board and period, member is uid,
+// periodic board use new key each period, old one archived to mgo
+
+type LeaderboardEntry struct {
+	Rank  int    `bson:"rank" json:"rank"` // 1-based
+	Uid   string `bson:"uid" json:"uid"`
+	Score int64  `bson:"score" json:"score"`
+}
+
+type LeaderboardArchive struct {
+	Id        bson.ObjectId       `bson:"_id,omitempty" json:"id"`
+	Board     string              `bson:"board" json:"board"`
+	Period    string              `bson:"period" json:"period"`
+	Entries   []*LeaderboardEntry `bson:"entries" json:"entries"` // top of board
+	CreatedAt time.Time           `bson:"created_at" json:"created_at"`
+}
+
+// Leaderboard key at redis, period empty as never reset
+func LeaderboardRedisKey(board, period string) string {
+	if period == "" {
+		return "leaderboard:" + board
+	}
+	return "leaderboard:" + board + ":" + period
+}
+
+// Limit submit of user to board once per interval, false if submitted
+// within interval
+func AllowScoreSubmit(board, uid string, interval time.Duration) (bool, error) {
+	return cacheStore.SetNxEx("leaderboard:submit:"+board+":"+uid, interval, "1")
+}
+
+// Keep best score of user, expire zero as never, return entry with best score
+func SubmitScore(board, period, uid string, score int64, expire time.Duration) (*LeaderboardEntry, error) {
+	key := LeaderboardRedisKey(board, period)
+
+	best, err := cacheStore.ZAddMax(key, uid, score)
+	if err != nil {
+		return nil, err
+	}
+	if expire > 0 {
+		if err := cacheStore.Expire(key, expire); err != nil {
+			return nil, err
+		}
+	}
+
+	rank, err := cacheStore.ZRevRank(key, uid)
+	if err != nil {
+		return nil, err
+	}
+	return &LeaderboardEntry{Rank: rank + 1, Uid: uid, Score: best}, nil
+}
+
+// Top n entries
+func GetLeaderboardTop(board, period string, n int) ([]*LeaderboardEntry, error) {
+	return leaderboardRange(LeaderboardRedisKey(board, period), 0, n-1)
+}
+
+// n entries with user at middle, ErrNotFound if user has no score
+func GetLeaderboardAround(board, period, uid string, n int) ([]*LeaderboardEntry, error) {
+	key := LeaderboardRedisKey(board, period)
+
+	rank, err := cacheStore.ZRevRank(key, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	start := rank - n/2
+	if start < 0 {
+		start = 0
+	}
+	return leaderboardRange(key, start, start+n-1)
+}
+
+func leaderboardRange(key string, start, stop int) ([]*LeaderboardEntry, error) {
+	members, err := cacheStore.ZRevRange(key, start, stop)
+	if err != nil {
+		return nil, err
+	}
+
+	rst := []*LeaderboardEntry{}
+	for i, m := range members {
+		rst = append(rst, &LeaderboardEntry{Rank: start + i + 1, Uid: m.Member, Score: m.Score})
+	}
+	return rst, nil
+}
+
+// Save top n of board period into mgo, ErrDuplicate if archived
+func ArchiveLeaderboard(board, period string, n int) error {
+	entries, err := GetLeaderboardTop(board, period, n)
+	if err != nil {
+		return err
+	}
+
+	return leaderboardStore.CreateLeaderboardArchive(&LeaderboardArchive{
+		Id:        bson.NewObjectId(),
+		Board:     board,
+		Period:    period,
+		Entries:   entries,
+		CreatedAt: time.Now(),
+	})
+}
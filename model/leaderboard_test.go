@@ -0,0 +1,25 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAllowScoreSubmit(t *testing.T) {
+	useMemoryStores(t)
+
+	if ok, err := AllowScoreSubmit("score", "u1", 50*time.Millisecond); err != nil || !ok {
+		t.Fatalf("ok = %v, err = %v, want first submit allowed", ok, err)
+	}
+	if ok, err := AllowScoreSubmit("score", "u1", 50*time.Millisecond); err != nil || ok {
+		t.Fatalf("ok = %v, err = %v, want submit in interval rejected", ok, err)
+	}
+	if ok, err := AllowScoreSubmit("score_daily", "u1", 50*time.Millisecond); err != nil || !ok {
+		t.Fatalf("ok = %v, err = %v, want other board allowed", ok, err)
+	}
+
+	time.Sleep(60 * time.Millisecond)
+	if ok, err := AllowScoreSubmit("score", "u1", 50*time.Millisecond); err != nil || !ok {
+		t.Fatalf("ok = %v, err = %v, want submit after interval allowed", ok, err)
+	}
+}
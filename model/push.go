@@ -0,0 +1,55 @@
+package model
+
+import (
+	"sort"
+
+	"gopkg.in/mgo.v2/bson"
+)
+
+// Critical pushes not acked when session ended, delivered at next login,
+// kept as encoded pb.Message
+
+func PendingPushesRedisKey(uid string) string {
+	return "pushes:pending:" + uid
+}
+
+// Append pushes of user, expire with sid
+func SavePendingPushes(uid string, pushes [][]byte) error {
+	if len(pushes) == 0 {
+		return nil
+	}
+	// object id as field, keep pushes in time order
+	fields := make(map[string]string)
+	for _, data := range pushes {
+		fields[bson.NewObjectId().Hex()] = string(data)
+	}
+	key := PendingPushesRedisKey(uid)
+	if err := cacheStore.HMSet(key, fields); err != nil {
+		return err
+	}
+	return cacheStore.Expire(key, SidExpire)
+}
+
+// Load pushes of user in time order, and remove them
+func TakePendingPushes(uid string) ([][]byte, error) {
+	key := PendingPushesRedisKey(uid)
+	fields, err := cacheStore.HGetAll(key)
+	if err != nil || len(fields) == 0 {
+		return nil, err
+	}
+	if err := cacheStore.Del(key); err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(fields))
+	for id := range fields {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	pushes := make([][]byte, 0, len(ids))
+	for _, id := range ids {
+		pushes = append(pushes, []byte(fields[id]))
+	}
+	return pushes, nil
+}
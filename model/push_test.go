@@ -0,0 +1,28 @@
+package model
+
+import (
+	"testing"
+)
+
+func TestPendingPushes(t *testing.T) {
+	useMemoryStores(t)
+
+	if err := SavePendingPushes("u1", [][]byte{[]byte("a"), []byte("b")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := SavePendingPushes("u1", [][]byte{[]byte("c")}); err != nil {
+		t.Fatal(err)
+	}
+
+	pushes, err := TakePendingPushes("u1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pushes) != 3 || string(pushes[0]) != "a" || string(pushes[1]) != "b" || string(pushes[2]) != "c" {
+		t.Fatalf("pushes = %q, want in save order", pushes)
+	}
+
+	if pushes, err := TakePendingPushes("u1"); err != nil || len(pushes) != 0 {
+		t.Fatalf("pushes = %q, err = %v, want taken", pushes, err)
+	}
+}
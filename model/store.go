@@ -0,0 +1,115 @@
+package model
+
+import (
+	"errors"
+	"game_server/common"
+	"log"
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+)
+
+// Model functions use stores instead of mgo and redis directly,
+// default is mgo and redis, memory stores for test without them
+
+var ErrNotFound = errors.New("not found")
+
+// Unique field existed, e.g. email
+var ErrDuplicate = errors.New("duplicate")
+
+// Persist users, default mgo
+type UserStore interface {
+	FindUser(query bson.M) (*User, error)
+	FindUsersByIds(ids []bson.ObjectId) ([]*User, error) // not existed ones skipped
+	CreateUser(usr *User) error                          // ErrDuplicate if email or sid existed
+	UpdateUser(id string, fields bson.M) error           // only set given fields, nil value to unset
+}
+
+// Cache with expire, default redis
+type CacheStore interface {
+	Get(key string) (string, error)
+	SetEx(key string, expire time.Duration, value string) error
+	SetNxEx(key string, expire time.Duration, value string) (bool, error) // false if key existed
+	Del(key string) error
+	DelIfEqual(key, value string, keys ...string) (bool, error)           // delete key and keys if key value equal, atomic
+	Rename(key, newKey string) error                                      // overwrite newKey, ErrNotFound if key not exist
+	ClaimEx(key string, expire time.Duration, value string) (bool, error) // SetEx if not exist or value equal, atomic
+	HExists(key, field string) (bool, error)
+	HGetAll(key string) (map[string]string, error)
+	HSet(key, field, value string) error
+	HMSet(key string, fields map[string]string) error
+	SAdd(key string, members ...string) error
+	SRem(key string, members ...string) error
+	SMembers(key string) ([]string, error)
+	Expire(key string, expire time.Duration) error
+	ZAddMax(key, member string, score int64) (int64, error)   // keep greater score, return kept one
+	ZRevRange(key string, start, stop int) ([]ZMember, error) // high score first, stop inclusive
+	ZRevRank(key, member string) (int, error)                 // 0-based, ErrNotFound if not exist
+}
+
+// Sorted set member, score as int64 for game score
+type ZMember struct {
+	Member string
+	Score  int64
+}
+
+// Persist chat messages, default mgo
+type ChatStore interface {
+	CreateChatMessage(msg *ChatMessage) error
+	FindChatMessages(channel, before string, limit int) ([]*ChatMessage, error) // latest first
+}
+
+// Persist friends and friend requests, default mgo
+type FriendStore interface {
+	FindFriend(uid, friendUid string) (*Friend, error)
+	FindFriends(uid string) ([]*Friend, error)
+	CreateFriend(f *Friend) error // ErrDuplicate if existed
+	DeleteFriend(uid, friendUid string) error
+	FindFriendRequests(to string) ([]*FriendRequest, error)
+	CreateFriendRequest(req *FriendRequest) error // ErrDuplicate if existed
+	DeleteFriendRequest(from, to string) error
+}
+
+// Persist offline direct messages, default mgo
+type InboxStore interface {
+	CreateInboxMessage(msg *InboxMessage) error
+	FindInboxMessages(to string, limit int) ([]*InboxMessage, error) // oldest first
+	DeleteInboxMessages(to string, last bson.ObjectId) error
+}
+
+// Persist leaderboard archives, default mgo
+type LeaderboardStore interface {
+	CreateLeaderboardArchive(archive *LeaderboardArchive) error // ErrDuplicate if archived
+}
+
+var userStore UserStore = &mgoUserStore{}
+var cacheStore CacheStore = &redisCacheStore{}
+var chatStore ChatStore = &mgoChatStore{}
+var friendStore FriendStore = &mgoFriendStore{}
+var inboxStore InboxStore = &mgoInboxStore{}
+var leaderboardStore LeaderboardStore = &mgoLeaderboardStore{}
+
+// Select stores at startup, backend is "memory" or default as mgo and redis.
+// Memory stores and broker live in each process, gateway and service do not
+// share users, pushes or pub/sub then, so memory is only for tests and tools
+// in single process, never for a gateway and service deployment
+func InitStores(backend string) {
+	switch backend {
+	case "memory":
+		log.Println("memory storage is per process, only for test, gateway and service not share data")
+		SetStores(NewMemoryUserStore(), NewMemoryCacheStore(), NewMemoryChatStore(), NewMemoryFriendStore(), NewMemoryInboxStore(), NewMemoryLeaderboardStore())
+		common.SetBroker(common.NewMemoryBroker())
+	default:
+		SetStores(&mgoUserStore{}, &redisCacheStore{}, &mgoChatStore{}, &mgoFriendStore{}, &mgoInboxStore{}, &mgoLeaderboardStore{})
+		common.GetMgo() // dial and ensure indexes at start
+	}
+}
+
+func SetStores(users UserStore, cache CacheStore, chats ChatStore, friends FriendStore, inbox InboxStore, leaderboards LeaderboardStore) {
+	userStore = users
+	cacheStore = cache
+	chatStore = chats
+	friendStore = friends
+	inboxStore = inbox
+	leaderboardStore = leaderboards
+}
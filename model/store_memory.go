@@ -0,0 +1,623 @@
+package model
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+)
+
+// Memory stores keep data in process, lost after exit, only for test
+
+type memoryUserStore struct {
+	mu    sync.Mutex
+	users map[bson.ObjectId]bson.M // saved as bson doc, same as mgo
+}
+
+func NewMemoryUserStore() UserStore {
+	return &memoryUserStore{
+		users: make(map[bson.ObjectId]bson.M),
+	}
+}
+
+// Only support equal query on fields
+func (s *memoryUserStore) FindUser(query bson.M) (*User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, doc := range s.users {
+		if matchDoc(doc, query) {
+			usr := &User{}
+			if err := convertDoc(doc, usr); err != nil {
+				return nil, err
+			}
+			return usr, nil
+		}
+	}
+
+	return nil, ErrNotFound
+}
+
+func (s *memoryUserStore) FindUsersByIds(ids []bson.ObjectId) ([]*User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	users := []*User{}
+	for _, id := range ids {
+		doc, ok := s.users[id]
+		if !ok {
+			continue
+		}
+		usr := &User{}
+		if err := convertDoc(doc, usr); err != nil {
+			return nil, err
+		}
+		users = append(users, usr)
+	}
+
+	return users, nil
+}
+
+func (s *memoryUserStore) CreateUser(usr *User) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if usr.Id == "" {
+		usr.Id = bson.NewObjectId()
+	}
+	if _, ok := s.users[usr.Id]; ok {
+		return errors.New("user id existed")
+	}
+
+	doc := bson.M{}
+	if err := convertDoc(usr, &doc); err != nil {
+		return err
+	}
+	if s.duplicate(usr.Id, doc) {
+		return ErrDuplicate
+	}
+	s.users[usr.Id] = doc
+	return nil
+}
+
+func (s *memoryUserStore) UpdateUser(id string, fields bson.M) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	doc, ok := s.users[bson.ObjectIdHex(id)]
+	if !ok {
+		return ErrNotFound
+	}
+
+	updated := bson.M{}
+	for k, v := range doc {
+		updated[k] = v
+	}
+	for k, v := range fields {
+		if v == nil {
+			delete(updated, k)
+		} else {
+			updated[k] = v
+		}
+	}
+	if s.duplicate(bson.ObjectIdHex(id), updated) {
+		return ErrDuplicate
+	}
+	s.users[bson.ObjectIdHex(id)] = updated
+	return nil
+}
+
+// Same as mgo unique indexes, email unique, sid unique if set
+func (s *memoryUserStore) duplicate(id bson.ObjectId, doc bson.M) bool {
+	for otherId, other := range s.users {
+		if otherId == id {
+			continue
+		}
+		if other["email"] == doc["email"] {
+			return true
+		}
+		if sid, ok := doc["sid"]; ok && other["sid"] == sid {
+			return true
+		}
+	}
+	return false
+}
+
+func matchDoc(doc, query bson.M) bool {
+	for k, v := range query {
+		if doc[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// Convert by bson marshal, so value types are same as mgo
+func convertDoc(in, out interface{}) error {
+	data, err := bson.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return bson.Unmarshal(data, out)
+}
+
+type memoryCacheItem struct {
+	value    string
+	hash     map[string]string
+	set      map[string]bool
+	zset     map[string]int64
+	expireAt time.Time // zero as never expire
+}
+
+type memoryCacheStore struct {
+	mu    sync.Mutex
+	items map[string]*memoryCacheItem
+}
+
+func NewMemoryCacheStore() CacheStore {
+	return &memoryCacheStore{
+		items: make(map[string]*memoryCacheItem),
+	}
+}
+
+// Get item not expired, expired item is deleted
+func (s *memoryCacheStore) item(key string) *memoryCacheItem {
+	item, ok := s.items[key]
+	if !ok {
+		return nil
+	}
+	if !item.expireAt.IsZero() && time.Now().After(item.expireAt) {
+		delete(s.items, key)
+		return nil
+	}
+	return item
+}
+
+func (s *memoryCacheStore) Get(key string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.item(key)
+	if item == nil || item.hash != nil || item.set != nil || item.zset != nil {
+		return "", ErrNotFound
+	}
+	return item.value, nil
+}
+
+func (s *memoryCacheStore) SetEx(key string, expire time.Duration, value string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.items[key] = &memoryCacheItem{value: value, expireAt: time.Now().Add(expire)}
+	return nil
+}
+
+func (s *memoryCacheStore) SetNxEx(key string, expire time.Duration, value string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.item(key) != nil {
+		return false, nil
+	}
+	s.items[key] = &memoryCacheItem{value: value, expireAt: time.Now().Add(expire)}
+	return true, nil
+}
+
+func (s *memoryCacheStore) Del(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.items, key)
+	return nil
+}
+
+func (s *memoryCacheStore) DelIfEqual(key, value string, keys ...string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.item(key)
+	if item == nil || item.hash != nil || item.set != nil || item.zset != nil || item.value != value {
+		return false, nil
+	}
+	delete(s.items, key)
+	for _, k := range keys {
+		delete(s.items, k)
+	}
+	return true, nil
+}
+
+func (s *memoryCacheStore) Rename(key, newKey string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.item(key)
+	if item == nil {
+		return ErrNotFound
+	}
+	s.items[newKey] = item
+	delete(s.items, key)
+	return nil
+}
+
+func (s *memoryCacheStore) ClaimEx(key string, expire time.Duration, value string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if item := s.item(key); item != nil && item.value != value {
+		return false, nil
+	}
+	s.items[key] = &memoryCacheItem{value: value, expireAt: time.Now().Add(expire)}
+	return true, nil
+}
+
+func (s *memoryCacheStore) HExists(key, field string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.item(key)
+	if item == nil {
+		return false, nil
+	}
+	_, ok := item.hash[field]
+	return ok, nil
+}
+
+func (s *memoryCacheStore) HGetAll(key string) (map[string]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rst := make(map[string]string)
+	if item := s.item(key); item != nil {
+		for k, v := range item.hash {
+			rst[k] = v
+		}
+	}
+	return rst, nil
+}
+
+func (s *memoryCacheStore) HSet(key, field, value string) error {
+	return s.HMSet(key, map[string]string{field: value})
+}
+
+func (s *memoryCacheStore) HMSet(key string, fields map[string]string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.item(key)
+	if item == nil || item.hash == nil {
+		item = &memoryCacheItem{hash: make(map[string]string)}
+		s.items[key] = item
+	}
+	for k, v := range fields {
+		item.hash[k] = v
+	}
+	return nil
+}
+
+func (s *memoryCacheStore) SAdd(key string, members ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.item(key)
+	if item == nil || item.set == nil {
+		item = &memoryCacheItem{set: make(map[string]bool)}
+		s.items[key] = item
+	}
+	for _, member := range members {
+		item.set[member] = true
+	}
+	return nil
+}
+
+func (s *memoryCacheStore) SRem(key string, members ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.item(key)
+	if item == nil {
+		return nil
+	}
+	for _, member := range members {
+		delete(item.set, member)
+	}
+	if len(item.set) == 0 {
+		delete(s.items, key) // same as redis, empty set removed
+	}
+	return nil
+}
+
+func (s *memoryCacheStore) SMembers(key string) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rst := []string{}
+	if item := s.item(key); item != nil {
+		for member := range item.set {
+			rst = append(rst, member)
+		}
+	}
+	return rst, nil
+}
+
+func (s *memoryCacheStore) Expire(key string, expire time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if item := s.item(key); item != nil {
+		item.expireAt = time.Now().Add(expire)
+	}
+	return nil
+}
+
+func (s *memoryCacheStore) ZAddMax(key, member string, score int64) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.item(key)
+	if item == nil || item.zset == nil {
+		item = &memoryCacheItem{zset: make(map[string]int64)}
+		s.items[key] = item
+	}
+	if cur, ok := item.zset[member]; ok && cur >= score {
+		return cur, nil
+	}
+	item.zset[member] = score
+	return score, nil
+}
+
+// Same order as redis, score desc then member desc
+func (s *memoryCacheStore) zrevSorted(key string) []ZMember {
+	rst := []ZMember{}
+	if item := s.item(key); item != nil {
+		for member, score := range item.zset {
+			rst = append(rst, ZMember{Member: member, Score: score})
+		}
+	}
+	sort.Slice(rst, func(i, j int) bool {
+		if rst[i].Score != rst[j].Score {
+			return rst[i].Score > rst[j].Score
+		}
+		return rst[i].Member > rst[j].Member
+	})
+	return rst
+}
+
+func (s *memoryCacheStore) ZRevRange(key string, start, stop int) ([]ZMember, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	members := s.zrevSorted(key)
+	if stop >= len(members) {
+		stop = len(members) - 1
+	}
+	if start < 0 || start > stop {
+		return []ZMember{}, nil
+	}
+	return members[start : stop+1], nil
+}
+
+func (s *memoryCacheStore) ZRevRank(key, member string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, m := range s.zrevSorted(key) {
+		if m.Member == member {
+			return i, nil
+		}
+	}
+	return 0, ErrNotFound
+}
+
+type memoryChatStore struct {
+	mu       sync.Mutex
+	channels map[string][]*ChatMessage // in id order
+}
+
+func NewMemoryChatStore() ChatStore {
+	return &memoryChatStore{
+		channels: make(map[string][]*ChatMessage),
+	}
+}
+
+func (s *memoryChatStore) CreateChatMessage(msg *ChatMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	msgs := append(s.channels[msg.Channel], msg)
+	sort.Slice(msgs, func(i, j int) bool { return msgs[i].Id < msgs[j].Id })
+	s.channels[msg.Channel] = msgs
+	return nil
+}
+
+func (s *memoryChatStore) FindChatMessages(channel, before string, limit int) ([]*ChatMessage, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rst := []*ChatMessage{}
+	msgs := s.channels[channel]
+	for i := len(msgs) - 1; i >= 0 && len(rst) < limit; i-- {
+		if before != "" && msgs[i].Id >= bson.ObjectIdHex(before) {
+			continue
+		}
+		rst = append(rst, msgs[i])
+	}
+	return rst, nil
+}
+
+type memoryFriendStore struct {
+	mu       sync.Mutex
+	friends  []*Friend // in id order
+	requests []*FriendRequest
+}
+
+func NewMemoryFriendStore() FriendStore {
+	return &memoryFriendStore{}
+}
+
+func (s *memoryFriendStore) FindFriend(uid, friendUid string) (*Friend, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, f := range s.friends {
+		if f.Uid == uid && f.FriendUid == friendUid {
+			return f, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (s *memoryFriendStore) FindFriends(uid string) ([]*Friend, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rst := []*Friend{}
+	for _, f := range s.friends {
+		if f.Uid == uid {
+			rst = append(rst, f)
+		}
+	}
+	return rst, nil
+}
+
+func (s *memoryFriendStore) CreateFriend(f *Friend) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, cur := range s.friends {
+		if cur.Uid == f.Uid && cur.FriendUid == f.FriendUid {
+			return ErrDuplicate
+		}
+	}
+	s.friends = append(s.friends, f)
+	return nil
+}
+
+func (s *memoryFriendStore) DeleteFriend(uid, friendUid string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, f := range s.friends {
+		if f.Uid == uid && f.FriendUid == friendUid {
+			s.friends = append(s.friends[:i], s.friends[i+1:]...)
+			return nil
+		}
+	}
+	return ErrNotFound
+}
+
+func (s *memoryFriendStore) FindFriendRequests(to string) ([]*FriendRequest, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rst := []*FriendRequest{}
+	for _, req := range s.requests {
+		if req.To == to {
+			rst = append(rst, req)
+		}
+	}
+	return rst, nil
+}
+
+func (s *memoryFriendStore) CreateFriendRequest(req *FriendRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, cur := range s.requests {
+		if cur.From == req.From && cur.To == req.To {
+			return ErrDuplicate
+		}
+	}
+	s.requests = append(s.requests, req)
+	return nil
+}
+
+func (s *memoryFriendStore) DeleteFriendRequest(from, to string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, req := range s.requests {
+		if req.From == from && req.To == to {
+			s.requests = append(s.requests[:i], s.requests[i+1:]...)
+			return nil
+		}
+	}
+	return ErrNotFound
+}
+
+type memoryInboxStore struct {
+	mu    sync.Mutex
+	inbox map[string][]*InboxMessage // in id order
+}
+
+func NewMemoryInboxStore() InboxStore {
+	return &memoryInboxStore{
+		inbox: make(map[string][]*InboxMessage),
+	}
+}
+
+func (s *memoryInboxStore) CreateInboxMessage(msg *InboxMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	msgs := append(s.inbox[msg.To], msg)
+	sort.Slice(msgs, func(i, j int) bool { return msgs[i].Id < msgs[j].Id })
+	s.inbox[msg.To] = msgs
+	return nil
+}
+
+func (s *memoryInboxStore) FindInboxMessages(to string, limit int) ([]*InboxMessage, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rst := []*InboxMessage{}
+	for _, msg := range s.inbox[to] {
+		if len(rst) >= limit {
+			break
+		}
+		rst = append(rst, msg)
+	}
+	return rst, nil
+}
+
+func (s *memoryInboxStore) DeleteInboxMessages(to string, last bson.ObjectId) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rst := []*InboxMessage{}
+	for _, msg := range s.inbox[to] {
+		if msg.Id > last {
+			rst = append(rst, msg)
+		}
+	}
+	if len(rst) == 0 {
+		delete(s.inbox, to)
+	} else {
+		s.inbox[to] = rst
+	}
+	return nil
+}
+
+type memoryLeaderboardStore struct {
+	mu       sync.Mutex
+	archives map[string]*LeaderboardArchive // use board and period as key
+}
+
+func NewMemoryLeaderboardStore() LeaderboardStore {
+	return &memoryLeaderboardStore{
+		archives: make(map[string]*LeaderboardArchive),
+	}
+}
+
+func (s *memoryLeaderboardStore) CreateLeaderboardArchive(archive *LeaderboardArchive) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := archive.Board + ":" + archive.Period
+	if _, ok := s.archives[key]; ok {
+		return ErrDuplicate
+	}
+	s.archives[key] = archive
+	return nil
+}
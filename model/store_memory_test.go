@@ -0,0 +1,29 @@
+package model
+
+import (
+	"testing"
+)
+
+// Fresh memory stores for each test
+func useMemoryStores(t *testing.T) {
+	t.Helper()
+	SetStores(NewMemoryUserStore(), NewMemoryCacheStore(), NewMemoryChatStore(), NewMemoryFriendStore(), NewMemoryInboxStore(), NewMemoryLeaderboardStore())
+}
+
+func TestMemoryCacheSet(t *testing.T) {
+	useMemoryStores(t)
+
+	if err := cacheStore.SAdd("set", "a", "b", "a"); err != nil {
+		t.Fatal(err)
+	}
+	if err := cacheStore.SRem("set", "b"); err != nil {
+		t.Fatal(err)
+	}
+	members, err := cacheStore.SMembers("set")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(members) != 1 || members[0] != "a" {
+		t.Fatalf("members = %v, want [a]", members)
+	}
+}
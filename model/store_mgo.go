@@ -0,0 +1,290 @@
+package model
+
+import (
+	"game_server/common"
+
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+type mgoUserStore struct{}
+
+func (s *mgoUserStore) FindUser(query bson.M) (*User, error) {
+	ms, err := common.GetMgo().NewSession()
+	if err != nil {
+		return nil, err
+	}
+	defer ms.Close()
+	c := ms.C("users")
+
+	usr := &User{}
+	err = c.Find(query).One(usr)
+	if err == mgo.ErrNotFound {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return usr, nil
+}
+
+func (s *mgoUserStore) FindUsersByIds(ids []bson.ObjectId) ([]*User, error) {
+	ms, err := common.GetMgo().NewSession()
+	if err != nil {
+		return nil, err
+	}
+	defer ms.Close()
+	c := ms.C("users")
+
+	users := []*User{}
+	err = c.Find(bson.M{"_id": bson.M{"$in": ids}}).All(&users)
+	if err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+func (s *mgoUserStore) CreateUser(usr *User) error {
+	ms, err := common.GetMgo().NewSession()
+	if err != nil {
+		return err
+	}
+	defer ms.Close()
+	c := ms.C("users")
+
+	err = c.Insert(usr)
+	if mgo.IsDup(err) {
+		return ErrDuplicate
+	}
+	return err
+}
+
+func (s *mgoUserStore) UpdateUser(id string, fields bson.M) error {
+	ms, err := common.GetMgo().NewSession()
+	if err != nil {
+		return err
+	}
+	defer ms.Close()
+	c := ms.C("users")
+
+	set, unset := bson.M{}, bson.M{}
+	for k, v := range fields {
+		if v == nil {
+			unset[k] = 1
+		} else {
+			set[k] = v
+		}
+	}
+	update := bson.M{}
+	if len(set) > 0 {
+		update["$set"] = set
+	}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+
+	err = c.Update(bson.M{"_id": bson.ObjectIdHex(id)}, update)
+	if err == mgo.ErrNotFound {
+		return ErrNotFound
+	}
+	if mgo.IsDup(err) {
+		return ErrDuplicate
+	}
+	return err
+}
+
+type mgoChatStore struct{}
+
+func (s *mgoChatStore) CreateChatMessage(msg *ChatMessage) error {
+	ms, err := common.GetMgo().NewSession()
+	if err != nil {
+		return err
+	}
+	defer ms.Close()
+	c := ms.C("chat_messages")
+
+	return c.Insert(msg)
+}
+
+func (s *mgoChatStore) FindChatMessages(channel, before string, limit int) ([]*ChatMessage, error) {
+	ms, err := common.GetMgo().NewSession()
+	if err != nil {
+		return nil, err
+	}
+	defer ms.Close()
+	c := ms.C("chat_messages")
+
+	query := bson.M{"channel": channel}
+	if before != "" {
+		query["_id"] = bson.M{"$lt": bson.ObjectIdHex(before)}
+	}
+
+	msgs := []*ChatMessage{}
+	err = c.Find(query).Sort("-_id").Limit(limit).All(&msgs)
+	return msgs, err
+}
+
+type mgoFriendStore struct{}
+
+func (s *mgoFriendStore) FindFriend(uid, friendUid string) (*Friend, error) {
+	ms, err := common.GetMgo().NewSession()
+	if err != nil {
+		return nil, err
+	}
+	defer ms.Close()
+	c := ms.C("friends")
+
+	f := &Friend{}
+	err = c.Find(bson.M{"uid": uid, "friend_uid": friendUid}).One(f)
+	if err == mgo.ErrNotFound {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func (s *mgoFriendStore) FindFriends(uid string) ([]*Friend, error) {
+	ms, err := common.GetMgo().NewSession()
+	if err != nil {
+		return nil, err
+	}
+	defer ms.Close()
+	c := ms.C("friends")
+
+	friends := []*Friend{}
+	err = c.Find(bson.M{"uid": uid}).Sort("_id").All(&friends)
+	return friends, err
+}
+
+func (s *mgoFriendStore) CreateFriend(f *Friend) error {
+	ms, err := common.GetMgo().NewSession()
+	if err != nil {
+		return err
+	}
+	defer ms.Close()
+	c := ms.C("friends")
+
+	err = c.Insert(f)
+	if mgo.IsDup(err) {
+		return ErrDuplicate
+	}
+	return err
+}
+
+func (s *mgoFriendStore) DeleteFriend(uid, friendUid string) error {
+	ms, err := common.GetMgo().NewSession()
+	if err != nil {
+		return err
+	}
+	defer ms.Close()
+	c := ms.C("friends")
+
+	err = c.Remove(bson.M{"uid": uid, "friend_uid": friendUid})
+	if err == mgo.ErrNotFound {
+		return ErrNotFound
+	}
+	return err
+}
+
+func (s *mgoFriendStore) FindFriendRequests(to string) ([]*FriendRequest, error) {
+	ms, err := common.GetMgo().NewSession()
+	if err != nil {
+		return nil, err
+	}
+	defer ms.Close()
+	c := ms.C("friend_requests")
+
+	reqs := []*FriendRequest{}
+	err = c.Find(bson.M{"to": to}).Sort("_id").All(&reqs)
+	return reqs, err
+}
+
+func (s *mgoFriendStore) CreateFriendRequest(req *FriendRequest) error {
+	ms, err := common.GetMgo().NewSession()
+	if err != nil {
+		return err
+	}
+	defer ms.Close()
+	c := ms.C("friend_requests")
+
+	err = c.Insert(req)
+	if mgo.IsDup(err) {
+		return ErrDuplicate
+	}
+	return err
+}
+
+func (s *mgoFriendStore) DeleteFriendRequest(from, to string) error {
+	ms, err := common.GetMgo().NewSession()
+	if err != nil {
+		return err
+	}
+	defer ms.Close()
+	c := ms.C("friend_requests")
+
+	err = c.Remove(bson.M{"from": from, "to": to})
+	if err == mgo.ErrNotFound {
+		return ErrNotFound
+	}
+	return err
+}
+
+type mgoInboxStore struct{}
+
+func (s *mgoInboxStore) CreateInboxMessage(msg *InboxMessage) error {
+	ms, err := common.GetMgo().NewSession()
+	if err != nil {
+		return err
+	}
+	defer ms.Close()
+	c := ms.C("inbox_messages")
+
+	return c.Insert(msg)
+}
+
+func (s *mgoInboxStore) FindInboxMessages(to string, limit int) ([]*InboxMessage, error) {
+	ms, err := common.GetMgo().NewSession()
+	if err != nil {
+		return nil, err
+	}
+	defer ms.Close()
+	c := ms.C("inbox_messages")
+
+	msgs := []*InboxMessage{}
+	err = c.Find(bson.M{"to": to}).Sort("_id").Limit(limit).All(&msgs)
+	return msgs, err
+}
+
+func (s *mgoInboxStore) DeleteInboxMessages(to string, last bson.ObjectId) error {
+	ms, err := common.GetMgo().NewSession()
+	if err != nil {
+		return err
+	}
+	defer ms.Close()
+	c := ms.C("inbox_messages")
+
+	_, err = c.RemoveAll(bson.M{"to": to, "_id": bson.M{"$lte": last}})
+	return err
+}
+
+type mgoLeaderboardStore struct{}
+
+func (s *mgoLeaderboardStore) CreateLeaderboardArchive(archive *LeaderboardArchive) error {
+	ms, err := common.GetMgo().NewSession()
+	if err != nil {
+		return err
+	}
+	defer ms.Close()
+	c := ms.C("leaderboard_archives")
+
+	err = c.Insert(archive)
+	if mgo.IsDup(err) {
+		return ErrDuplicate
+	}
+	return err
+}
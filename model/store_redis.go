@@ -0,0 +1,117 @@
+package model
+
+import (
+	"game_server/common"
+	"strings"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+type redisCacheStore struct{}
+
+func (s *redisCacheStore) Get(key string) (string, error) {
+	value, err := common.GetRedis().Get(key)
+	if err == redis.ErrNil {
+		return "", ErrNotFound
+	}
+	return value, err
+}
+
+func (s *redisCacheStore) SetEx(key string, expire time.Duration, value string) error {
+	return common.GetRedis().SetEx(key, int(expire.Seconds()), value)
+}
+
+func (s *redisCacheStore) SetNxEx(key string, expire time.Duration, value string) (bool, error) {
+	return common.GetRedis().SetNxPx(key, int64(expire/time.Millisecond), value)
+}
+
+func (s *redisCacheStore) Del(key string) error {
+	return common.GetRedis().Del(key)
+}
+
+func (s *redisCacheStore) HExists(key, field string) (bool, error) {
+	return common.GetRedis().HExists(key, field)
+}
+
+func (s *redisCacheStore) HGetAll(key string) (map[string]string, error) {
+	return common.GetRedis().HGetAll(key)
+}
+
+func (s *redisCacheStore) HSet(key, field, value string) error {
+	return common.GetRedis().HSet(key, field, value)
+}
+
+func (s *redisCacheStore) DelIfEqual(key, value string, keys ...string) (bool, error) {
+	return common.GetRedis().DelIfEqual(key, value, keys...)
+}
+
+func (s *redisCacheStore) Rename(key, newKey string) error {
+	err := common.GetRedis().Rename(key, newKey)
+	if err != nil && strings.Contains(err.Error(), "no such key") {
+		return ErrNotFound
+	}
+	return err
+}
+
+func (s *redisCacheStore) ClaimEx(key string, expire time.Duration, value string) (bool, error) {
+	return common.GetRedis().ClaimEx(key, int(expire.Seconds()), value)
+}
+
+func (s *redisCacheStore) HMSet(key string, fields map[string]string) error {
+	args := []interface{}{key}
+	for field, value := range fields {
+		args = append(args, field, value)
+	}
+	return common.GetRedis().HMSet(args...)
+}
+
+func (s *redisCacheStore) SAdd(key string, members ...string) error {
+	return common.GetRedis().SAdd(setArgs(key, members)...)
+}
+
+func (s *redisCacheStore) SRem(key string, members ...string) error {
+	return common.GetRedis().SRem(setArgs(key, members)...)
+}
+
+func (s *redisCacheStore) SMembers(key string) ([]string, error) {
+	return common.GetRedis().SMembers(key)
+}
+
+func (s *redisCacheStore) Expire(key string, expire time.Duration) error {
+	return common.GetRedis().Expire(key, int(expire.Seconds()))
+}
+
+func (s *redisCacheStore) ZAddMax(key, member string, score int64) (int64, error) {
+	rst, err := common.GetRedis().ZAddMax(key, float64(score), member)
+	return int64(rst), err
+}
+
+func (s *redisCacheStore) ZRevRange(key string, start, stop int) ([]ZMember, error) {
+	members, err := common.GetRedis().ZRevRangeWithScores(key, start, stop)
+	if err != nil {
+		return nil, err
+	}
+
+	rst := []ZMember{}
+	for _, m := range members {
+		rst = append(rst, ZMember{Member: m.Member, Score: int64(m.Score)})
+	}
+	return rst, nil
+}
+
+func (s *redisCacheStore) ZRevRank(key, member string) (int, error) {
+	rank, err := common.GetRedis().ZRevRank(key, member)
+	if err == redis.ErrNil {
+		return 0, ErrNotFound
+	}
+	return rank, err
+}
+
+func setArgs(key string, members []string) []interface{} {
+	args := []interface{}{key}
+	for _, member := range members {
+		args = append(args, member)
+	}
+	return args
+}
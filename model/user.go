@@ -1,24 +1,37 @@
 package model
 
 import (
-	"game_server/common"
-	"log"
+	"fmt"
 	"strconv"
 	"time"
 
 	"golang.org/x/crypto/bcrypt"
-	"gopkg.in/mgo.v2"
 	"gopkg.in/mgo.v2/bson"
 )
 
-// User depend on redis and mgo
+// User depend on cache store and user store, default redis and mgo
 // use user model as respositroy
 
+// Sid expire time, sid at redis will be deleted after
+const SidExpire = 7 * 24 * time.Hour
+
+// User session expire time, refreshed by gateway while connected, so
+// user crashed with gateway is offline after it
+const UserSessionExpire = 30 * time.Second
+
+// Matchmaking rating of new user
+const DefaultRating = 1000
+
 type User struct {
 	Id        bson.ObjectId `bson:"_id,omitempty" json:"id"`
 	Email     string        `bson:"email" json:"email"`
-	Password  string        `bson:"password" json:"-"` // save in hash
-	Sid       string        `bson:"sid" json:"-"`      // like token, as user certificate, default ''
+	Password  string        `bson:"password" json:"-"`        // save in hash
+	Sid       string        `bson:"sid,omitempty" json:"-"`   // like token, as user certificate, default '' and unset at mgo
+	Nickname  string        `bson:"nickname" json:"nickname"` // empty as default name
+	Avatar    int           `bson:"avatar" json:"avatar"`     // avatar id
+	Bio       string        `bson:"bio" json:"bio"`
+	Level     int           `bson:"level" json:"level"`   // server side only, not editable
+	Rating    int           `bson:"rating" json:"rating"` // matchmaking skill rating, server side only
 	CreatedAt time.Time     `bson:"created_at" json:"created_at"`
 	UpdatedAt time.Time     `bson:"updated_at" json:"updated_at"`
 }
@@ -31,6 +44,19 @@ func (m *User) GetEmail() string {
 	return m.Email
 }
 
+// Display name for other users, never expose email
+func (m *User) GetName() string {
+	if m.Nickname != "" {
+		return m.Nickname
+	}
+
+	id := m.GetId()
+	if len(id) > 6 {
+		id = id[len(id)-6:]
+	}
+	return "player_" + id
+}
+
 func (m *User) GetPassword() string {
 	return m.Password
 }
@@ -39,6 +65,30 @@ func (m *User) GetSid() string {
 	return m.Sid
 }
 
+func (m *User) GetNickname() string {
+	return m.Nickname
+}
+
+func (m *User) GetAvatar() int {
+	return m.Avatar
+}
+
+func (m *User) GetBio() string {
+	return m.Bio
+}
+
+func (m *User) GetLevel() int {
+	return m.Level
+}
+
+// Default rating if not set, user created before rating
+func (m *User) GetRating() int {
+	if m.Rating == 0 {
+		return DefaultRating
+	}
+	return m.Rating
+}
+
 func (m *User) GetCreatedAt() string { // get unix str
 	str := strconv.FormatInt(m.CreatedAt.Unix(), 10)
 	return str
@@ -57,19 +107,39 @@ func (m *User) SetEmail(email string) {
 	m.Email = email
 }
 
-func (m *User) SetPassword(pwd string) { // use hash
+func (m *User) SetPassword(pwd string) error { // use hash
 	hashPwd, err := bcrypt.GenerateFromPassword([]byte(pwd), bcrypt.DefaultCost)
 	if err != nil {
-		log.Println(err)
-		return
+		return err
 	}
 	m.Password = string(hashPwd)
+	return nil
 }
 
 func (m *User) SetSid(sid string) {
 	m.Sid = sid
 }
 
+func (m *User) SetNickname(nickname string) {
+	m.Nickname = nickname
+}
+
+func (m *User) SetAvatar(avatar int) {
+	m.Avatar = avatar
+}
+
+func (m *User) SetBio(bio string) {
+	m.Bio = bio
+}
+
+func (m *User) SetLevel(level int) {
+	m.Level = level
+}
+
+func (m *User) SetRating(rating int) {
+	m.Rating = rating
+}
+
 func (m *User) SetCreatedAt(str string) {
 	unix, _ := strconv.ParseInt(str, 10, 64)
 	m.CreatedAt = time.Unix(unix, 0)
@@ -80,104 +150,127 @@ func (m *User) SetUpdatedAt(str string) {
 	m.UpdatedAt = time.Unix(unix, 0)
 }
 
-// only update sid
-func (m *User) UpdateSid(sid string) {
-	ms := common.GetMgo().NewSession()
-	defer ms.Close()
-	c := ms.C("users")
+// only update sid, keep redis user storage same
+func (m *User) UpdateSid(sid string) error {
+	exists, err := UserStorageExists(m.GetId())
+	if err != nil {
+		return err
+	}
+	if exists {
+		if err := cacheStore.HSet(UserRedisKey(m.GetId()), "sid", sid); err != nil {
+			return err
+		}
+	}
 
+	m.Sid = sid
 	m.UpdatedAt = time.Now()
-	c.Update(bson.M{"_id": m.Id}, bson.M{
-		"$set": bson.M{
-			"sid":        sid,
-			"updated_at": m.UpdatedAt,
-		},
+
+	var value interface{} = sid
+	if sid == "" {
+		value = nil // unset, sid index is unique sparse
+	}
+	return userStore.UpdateUser(m.GetId(), bson.M{
+		"sid":        value,
+		"updated_at": m.UpdatedAt,
 	})
 }
 
-// Find user from mgo by email
-func FindUserById(id string) *User {
-	ms := common.GetMgo().NewSession()
-	defer ms.Close()
-	c := ms.C("users")
-
-	usr := &User{}
-	err := c.Find(bson.M{"_id": bson.ObjectIdHex(id)}).One(usr)
-	if err != nil {
-		if err != mgo.ErrNotFound {
-			log.Println(err)
-		}
-		return nil
+// Find user from mgo by id, ErrNotFound if not exist
+func FindUserById(id string) (*User, error) {
+	if !bson.IsObjectIdHex(id) {
+		return nil, ErrNotFound
 	}
-
-	return usr
+	return userStore.FindUser(bson.M{"_id": bson.ObjectIdHex(id)})
 }
 
-// Find user from mgo by email
-func FindUserByEmail(email string) *User {
-	ms := common.GetMgo().NewSession()
-	defer ms.Close()
-	c := ms.C("users")
-
-	usr := &User{}
-	err := c.Find(bson.M{"email": email}).One(usr)
-	if err != nil {
-		if err != mgo.ErrNotFound {
-			log.Println(err)
+// Find users from mgo by ids in one query, use id as key, not existed and
+// invalid ids are skipped
+func FindUsersByIds(ids []string) (map[string]*User, error) {
+	objectIds := make([]bson.ObjectId, 0, len(ids))
+	for _, id := range ids {
+		if bson.IsObjectIdHex(id) {
+			objectIds = append(objectIds, bson.ObjectIdHex(id))
 		}
-		return nil
+	}
+	users := make(map[string]*User)
+	if len(objectIds) == 0 {
+		return users, nil
 	}
 
-	return usr
-}
-
-// Find user from mgo by sid
-func FindUserBySid(sid string) *User {
-	ms := common.GetMgo().NewSession()
-	defer ms.Close()
-	c := ms.C("users")
-
-	usr := &User{}
-	err := c.Find(bson.M{"sid": sid}).One(usr)
+	found, err := userStore.FindUsersByIds(objectIds)
 	if err != nil {
-		if err != mgo.ErrNotFound {
-			log.Println(err)
-		}
-		return nil
+		return nil, err
 	}
+	for _, usr := range found {
+		users[usr.GetId()] = usr
+	}
+	return users, nil
+}
 
-	return usr
+// Find user from mgo by email, ErrNotFound if not exist
+func FindUserByEmail(email string) (*User, error) {
+	return userStore.FindUser(bson.M{"email": email})
 }
 
-// Create user into mgo
-func CreateUser(email, password string) {
-	ms := common.GetMgo().NewSession()
-	defer ms.Close()
-	c := ms.C("users")
+// Find user from mgo by sid, ErrNotFound if not exist
+func FindUserBySid(sid string) (*User, error) {
+	return userStore.FindUser(bson.M{"sid": sid})
+}
 
+// Create user into mgo, ErrDuplicate if email existed
+func CreateUser(email, password string) (*User, error) {
 	usr := &User{}
+	usr.Id = bson.NewObjectId()
 	usr.SetEmail(email)
-	usr.SetPassword(password)
+	if err := usr.SetPassword(password); err != nil {
+		return nil, err
+	}
+	usr.SetLevel(1)
+	usr.SetRating(DefaultRating)
 	usr.CreatedAt = time.Now()
 	usr.UpdatedAt = time.Now()
-	err := c.Insert(usr)
-	if err != nil {
-		log.Println(err)
+
+	if err := userStore.CreateUser(usr); err != nil {
+		return nil, err
 	}
+	return usr, nil
 }
 
-// Save data into mgo
-func (m *User) Save() {
-	ms := common.GetMgo().NewSession()
-	defer ms.Close()
-	c := ms.C("users")
+// Mutable fields saved to mgo, sid is saved by UpdateSid at once
+var UserMutableFields = []string{"email", "password", "nickname", "avatar", "bio", "level", "rating", "updated_at"}
+
+func (m *User) mutableValues() bson.M {
+	return bson.M{
+		"email":      m.GetEmail(),
+		"password":   m.GetPassword(),
+		"nickname":   m.GetNickname(),
+		"avatar":     m.GetAvatar(),
+		"bio":        m.GetBio(),
+		"level":      m.GetLevel(),
+		"rating":     m.GetRating(),
+		"updated_at": m.UpdatedAt,
+	}
+}
 
+// Save all mutable fields into mgo
+func (m *User) Save() error {
 	m.UpdatedAt = time.Now()
-	c.Update(bson.M{"_id": m.Id}, bson.M{
-		"$set": bson.M{
-			"updated_at": m.UpdatedAt, // no save sid, password, email, created_at etc..
-		},
-	})
+	return m.saveFields(UserMutableFields)
+}
+
+// Save given mutable fields into mgo, unknown field is ignored
+func (m *User) saveFields(fields []string) error {
+	values := m.mutableValues()
+	update := bson.M{}
+	for _, field := range fields {
+		if value, ok := values[field]; ok {
+			update[field] = value
+		}
+	}
+	if len(update) == 0 {
+		return nil
+	}
+	return userStore.UpdateUser(m.GetId(), update)
 }
 
 // User key at redis
@@ -185,79 +278,235 @@ func UserRedisKey(id string) string {
 	return "users:" + id
 }
 
+// Set of user ids with dirty fields at redis
+const DirtyUsersRedisKey = "users:dirty"
+
+// Set of dirty fields of user at redis
+func UserDirtyRedisKey(id string) string {
+	return "users:dirty:" + id
+}
+
+// Owner of user storage at redis, value is id of gateway client session,
+// also as presence, exists only while session alive at any gateway
+func UserSessionRedisKey(id string) string {
+	return "users:session:" + id
+}
+
 // Sid key at redis
 func SidRedisKey(sid string) string {
 	return "sid:" + sid
 }
 
+// Storage sid into redis with expire, value is user id
+func (m *User) StorageSid(sid string) error {
+	key := SidRedisKey(sid)
+	return cacheStore.SetEx(key, SidExpire, m.GetId())
+}
+
+// Find user id from redis by sid, ErrNotFound if sid expired or revoked
+func LoadUidBySid(sid string) (string, error) {
+	key := SidRedisKey(sid)
+	return cacheStore.Get(key)
+}
+
+// Clear sid storage, sid is invalid after
+func ClearSidStorage(sid string) error {
+	key := SidRedisKey(sid)
+	return cacheStore.Del(key)
+}
+
 // Redis user exists
-func UserStorageExists(id string) bool {
+func UserStorageExists(id string) (bool, error) {
 	key := UserRedisKey(id)
-	bol, _ := common.GetRedis().HExists(key, "id")
-	return bol
+	return cacheStore.HExists(key, "id")
 }
 
-// Find user from redis by id
-func LoadUserById(id string) *User {
+// Find user from redis by id, ErrNotFound if not exist
+func LoadUserById(id string) (*User, error) {
 	key := UserRedisKey(id)
 
-	uMap, err := common.GetRedis().HGetAll(key)
+	uMap, err := cacheStore.HGetAll(key)
 	if err != nil {
-		log.Println(err)
-		return nil
+		return nil, err
 	}
 
-	if len(uMap) == 0 {
-		return nil
+	if len(uMap) == 0 || !bson.IsObjectIdHex(uMap["id"]) {
+		return nil, ErrNotFound
 	}
 
 	usr := &User{}
 	usr.SetId(uMap["id"])
 	usr.SetEmail(uMap["email"])
-	usr.SetPassword(uMap["password"])
+	usr.Password = uMap["password"] // hash already
 	usr.SetSid(uMap["sid"])
+	usr.SetNickname(uMap["nickname"])
+	usr.Avatar, _ = strconv.Atoi(uMap["avatar"])
+	usr.SetBio(uMap["bio"])
+	usr.Level, _ = strconv.Atoi(uMap["level"])
+	usr.Rating, _ = strconv.Atoi(uMap["rating"])
 	usr.SetCreatedAt(uMap["created_at"])
 	usr.SetUpdatedAt(uMap["updated_at"])
 
-	return usr
+	return usr, nil
 }
 
 // Storage data into redis
-func (m *User) Storage() {
+func (m *User) Storage() error {
 	key := UserRedisKey(m.GetId())
 
 	m.UpdatedAt = time.Now()
-	common.GetRedis().HMSet(key,
-		"id", m.GetId(),
-		"email", m.GetEmail(),
-		"password", m.GetPassword(),
-		"sid", m.GetSid(),
-		"created_at", m.GetCreatedAt(),
-		"updated_at", m.GetUpdatedAt(),
-	)
-}
-
-// Clear user storage data
-func (m *User) ClearStorage() {
-	key := UserRedisKey(m.GetId())
-	common.GetRedis().Del(key)
+	return cacheStore.HMSet(key, map[string]string{
+		"id":         m.GetId(),
+		"email":      m.GetEmail(),
+		"password":   m.GetPassword(),
+		"sid":        m.GetSid(),
+		"nickname":   m.GetNickname(),
+		"avatar":     strconv.Itoa(m.GetAvatar()),
+		"bio":        m.GetBio(),
+		"level":      strconv.Itoa(m.GetLevel()),
+		"rating":     strconv.Itoa(m.GetRating()),
+		"created_at": m.GetCreatedAt(),
+		"updated_at": m.GetUpdatedAt(),
+	})
+}
+
+// Claim user storage for new session before reuse or storage it, so old
+// session exiting at any gateway keep it
+func ClaimUserSession(id, owner string) error {
+	return cacheStore.SetEx(UserSessionRedisKey(id), UserSessionExpire, owner)
+}
+
+// Extend expire of session, claim again if expired, false if claimed by
+// new session
+func RefreshUserSession(id, owner string) (bool, error) {
+	return cacheStore.ClaimEx(UserSessionRedisKey(id), UserSessionExpire, owner)
+}
+
+// User session alive at any gateway
+func UserOnline(id string) (bool, error) {
+	_, err := cacheStore.Get(UserSessionRedisKey(id))
+	if err == ErrNotFound {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// Release claim without clear, e.g. storage kept as save failed, false if
+// claimed by new session
+func ReleaseUserSession(id, owner string) (bool, error) {
+	return cacheStore.DelIfEqual(UserSessionRedisKey(id), owner)
+}
+
+// Clear user storage data and dirty mark if owner still claim it, save it
+// before, false if claimed by new session. Id at dirty set is removed by
+// next flush, remove here may lose mark of new session
+func (m *User) ClearStorage(owner string) (bool, error) {
+	id := m.GetId()
+	return cacheStore.DelIfEqual(UserSessionRedisKey(id), owner, UserRedisKey(id), UserDirtyRedisKey(id), userFlushingRedisKey(id))
+}
+
+// Mark redis user fields changed, flushed to mgo later by FlushDirtyUsers
+func MarkUserDirty(id string, fields ...string) error {
+	if len(fields) == 0 {
+		return nil
+	}
+	if err := cacheStore.SAdd(UserDirtyRedisKey(id), fields...); err != nil {
+		return err
+	}
+	return cacheStore.SAdd(DirtyUsersRedisKey, id)
+}
+
+// Dirty fields being flushed, moved from dirty key at once
+func userFlushingRedisKey(id string) string {
+	return "users:flushing:" + id
+}
+
+// Write dirty fields of redis user into mgo, mark again if failed.
+// Fields are moved to flushing key by rename, so mark during flush is kept
+// at dirty key for next flush
+func FlushUser(id string) error {
+	// remove id before move, mark after it add id again
+	if err := cacheStore.SRem(DirtyUsersRedisKey, id); err != nil {
+		return err
+	}
+
+	// fields left by flush broken before
+	flushing := userFlushingRedisKey(id)
+	left, err := cacheStore.SMembers(flushing)
+	if err != nil {
+		return err
+	}
+	err = cacheStore.Rename(UserDirtyRedisKey(id), flushing)
+	if err != nil && err != ErrNotFound {
+		return err
+	}
+	fields, err := cacheStore.SMembers(flushing)
+	if err != nil {
+		return err
+	}
+	fields = append(fields, left...)
+	if len(fields) == 0 {
+		return nil
+	}
+
+	usr, err := LoadUserById(id)
+	if err == ErrNotFound {
+		// dirty but storage gone, changes lost, never happen if exit saved
+		cacheStore.Del(flushing)
+		return fmt.Errorf("user storage missing, dirty fields lost, uid: %s fields: %v", id, fields)
+	}
+	if err == nil {
+		err = usr.saveFields(fields)
+	}
+	if err != nil {
+		// retry at next flush, kept at flushing if mark failed
+		if MarkUserDirty(id, fields...) == nil {
+			cacheStore.Del(flushing)
+		}
+		return err
+	}
+	return cacheStore.Del(flushing)
+}
+
+// Flush all dirty users, failed one is kept and retried next time
+func FlushDirtyUsers() error {
+	ids, err := cacheStore.SMembers(DirtyUsersRedisKey)
+	if err != nil {
+		return err
+	}
+
+	var firstErr error
+	for _, id := range ids {
+		if err := FlushUser(id); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
 }
 
 // Get user, first check redis, if nil then use mgo
-func GetUserById(id string) (usr *User) {
-	if UserStorageExists(id) {
-		usr = LoadUserById(id)
-	} else {
-		usr = FindUserById(id)
+func GetUserById(id string) (*User, error) {
+	exists, err := UserStorageExists(id)
+	if err != nil {
+		return nil, err
 	}
-	return
+	if exists {
+		return LoadUserById(id)
+	}
+	return FindUserById(id)
 }
 
 // Set user, first check redis, if nil then use redis
-func (m *User) Set() {
-	if UserStorageExists(m.GetId()) {
-		m.Storage()
-	} else {
-		m.Save()
+func (m *User) Set() error {
+	exists, err := UserStorageExists(m.GetId())
+	if err != nil {
+		return err
+	}
+	if exists {
+		if err := m.Storage(); err != nil {
+			return err
+		}
+		return MarkUserDirty(m.GetId(), UserMutableFields...)
 	}
+	return m.Save()
 }
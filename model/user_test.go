@@ -0,0 +1,156 @@
+package model
+
+import (
+	"testing"
+
+	"gopkg.in/mgo.v2/bson"
+)
+
+func TestCreateUserDuplicate(t *testing.T) {
+	useMemoryStores(t)
+
+	if _, err := CreateUser("a@test.com", "password"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := CreateUser("a@test.com", "password"); err != ErrDuplicate {
+		t.Fatalf("err = %v, want ErrDuplicate", err)
+	}
+}
+
+func TestFindUsersByIds(t *testing.T) {
+	useMemoryStores(t)
+
+	a, err := CreateUser("a@test.com", "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := CreateUser("b@test.com", "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	users, err := FindUsersByIds([]string{a.GetId(), b.GetId(), "invalid", bson.NewObjectId().Hex()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 2 || users[a.GetId()].GetEmail() != "a@test.com" || users[b.GetId()].GetEmail() != "b@test.com" {
+		t.Fatalf("users = %v, want a and b only", users)
+	}
+}
+
+func TestUserStorage(t *testing.T) {
+	useMemoryStores(t)
+
+	usr, err := CreateUser("a@test.com", "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	usr.SetNickname("tester")
+	if err := usr.Storage(); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := LoadUserById(usr.GetId())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.GetNickname() != "tester" || loaded.GetEmail() != "a@test.com" {
+		t.Fatalf("loaded = %+v", loaded)
+	}
+
+	if err := ClaimUserSession(usr.GetId(), "old"); err != nil {
+		t.Fatal(err)
+	}
+	if err := ClaimUserSession(usr.GetId(), "new"); err != nil {
+		t.Fatal(err)
+	}
+	// old session exit after new claimed, storage kept for new
+	if cleared, err := loaded.ClearStorage("old"); err != nil || cleared {
+		t.Fatalf("cleared = %v, err = %v, want kept", cleared, err)
+	}
+	if _, err := LoadUserById(usr.GetId()); err != nil {
+		t.Fatal(err)
+	}
+
+	if cleared, err := loaded.ClearStorage("new"); err != nil || !cleared {
+		t.Fatalf("cleared = %v, err = %v, want cleared", cleared, err)
+	}
+	if _, err := LoadUserById(usr.GetId()); err != ErrNotFound {
+		t.Fatalf("err = %v, want ErrNotFound", err)
+	}
+}
+
+func TestFlushUser(t *testing.T) {
+	useMemoryStores(t)
+
+	usr, err := CreateUser("a@test.com", "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := usr.Storage(); err != nil {
+		t.Fatal(err)
+	}
+
+	// change at redis only, then flushed to mgo
+	usr.SetLevel(5)
+	if err := usr.Set(); err != nil {
+		t.Fatal(err)
+	}
+	if saved, _ := FindUserById(usr.GetId()); saved.GetLevel() != 1 {
+		t.Fatalf("level = %d before flush, want 1", saved.GetLevel())
+	}
+	if err := FlushDirtyUsers(); err != nil {
+		t.Fatal(err)
+	}
+	saved, err := FindUserById(usr.GetId())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved.GetLevel() != 5 {
+		t.Fatalf("level = %d after flush, want 5", saved.GetLevel())
+	}
+
+	ids, err := cacheStore.SMembers(DirtyUsersRedisKey)
+	if err != nil || len(ids) != 0 {
+		t.Fatalf("dirty users = %v, err = %v", ids, err)
+	}
+}
+
+func TestFlushUserStorageMissing(t *testing.T) {
+	useMemoryStores(t)
+
+	usr, err := CreateUser("a@test.com", "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := MarkUserDirty(usr.GetId(), "level"); err != nil {
+		t.Fatal(err)
+	}
+	// dirty fields without storage are reported, not taken as saved
+	if err := FlushUser(usr.GetId()); err == nil {
+		t.Fatal("err = nil, want storage missing")
+	}
+}
+
+func TestUserSession(t *testing.T) {
+	useMemoryStores(t)
+
+	if online, _ := UserOnline("u1"); online {
+		t.Fatal("online before claim")
+	}
+	if err := ClaimUserSession("u1", "old"); err != nil {
+		t.Fatal(err)
+	}
+	if online, _ := UserOnline("u1"); !online {
+		t.Fatal("offline after claim")
+	}
+	if err := ClaimUserSession("u1", "new"); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := RefreshUserSession("u1", "old"); err != nil || ok {
+		t.Fatalf("refresh = %v, err = %v, want claimed by new", ok, err)
+	}
+	if ok, err := RefreshUserSession("u1", "new"); err != nil || !ok {
+		t.Fatalf("refresh = %v, err = %v, want ok", ok, err)
+	}
+}
@@ -0,0 +1,134 @@
+package pb
+
+import (
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Error as go error, service return it to gateway by grpc status detail,
+// gateway send it to client by Rsp or http ErrorBag
+
+type errorStatus struct {
+	grpc codes.Code
+	http int
+}
+
+var errorStatuses = map[ErrorCode]errorStatus{
+	ErrorCode_ERR_UNKNOWN:           {codes.Unknown, http.StatusInternalServerError},
+	ErrorCode_ERR_INTERNAL:          {codes.Internal, http.StatusInternalServerError},
+	ErrorCode_ERR_INVALID_ARGUMENT:  {codes.InvalidArgument, http.StatusUnprocessableEntity},
+	ErrorCode_ERR_NOT_FOUND:         {codes.NotFound, http.StatusNotFound},
+	ErrorCode_ERR_ALREADY_EXISTS:    {codes.AlreadyExists, http.StatusConflict},
+	ErrorCode_ERR_UNAUTHENTICATED:   {codes.Unauthenticated, http.StatusUnauthorized},
+	ErrorCode_ERR_PERMISSION_DENIED: {codes.PermissionDenied, http.StatusForbidden},
+	ErrorCode_ERR_TOO_MANY_REQUESTS: {codes.ResourceExhausted, http.StatusTooManyRequests},
+	ErrorCode_ERR_TIMEOUT:           {codes.DeadlineExceeded, http.StatusGatewayTimeout},
+	ErrorCode_ERR_UNAVAILABLE:       {codes.Unavailable, http.StatusServiceUnavailable},
+	ErrorCode_ERR_UNKNOWN_REQUEST:   {codes.Unimplemented, http.StatusNotFound},
+
+	ErrorCode_ERR_EMAIL_INVALID:      {codes.InvalidArgument, http.StatusUnprocessableEntity},
+	ErrorCode_ERR_EMAIL_EXISTED:      {codes.AlreadyExists, http.StatusUnprocessableEntity},
+	ErrorCode_ERR_EMAIL_NOT_EXIST:    {codes.NotFound, http.StatusNotFound},
+	ErrorCode_ERR_PASSWORD_INVALID:   {codes.InvalidArgument, http.StatusUnprocessableEntity},
+	ErrorCode_ERR_PASSWORD_WRONG:     {codes.InvalidArgument, http.StatusBadRequest},
+	ErrorCode_ERR_TOKEN_MISSING:      {codes.Unauthenticated, http.StatusUnauthorized},
+	ErrorCode_ERR_TOKEN_INVALID:      {codes.Unauthenticated, http.StatusUnauthorized},
+	ErrorCode_ERR_USER_NOT_FOUND:     {codes.NotFound, http.StatusNotFound},
+	ErrorCode_ERR_CHANNEL_INVALID:    {codes.InvalidArgument, http.StatusUnprocessableEntity},
+	ErrorCode_ERR_CHANNEL_NOT_JOINED: {codes.PermissionDenied, http.StatusForbidden},
+	ErrorCode_ERR_CURSOR_INVALID:     {codes.InvalidArgument, http.StatusUnprocessableEntity},
+
+	ErrorCode_ERR_FRIEND_SELF:              {codes.InvalidArgument, http.StatusUnprocessableEntity},
+	ErrorCode_ERR_FRIEND_EXISTED:           {codes.AlreadyExists, http.StatusConflict},
+	ErrorCode_ERR_FRIEND_REQUEST_EXISTED:   {codes.AlreadyExists, http.StatusConflict},
+	ErrorCode_ERR_FRIEND_REQUEST_NOT_FOUND: {codes.NotFound, http.StatusNotFound},
+	ErrorCode_ERR_FRIEND_NOT_FOUND:         {codes.NotFound, http.StatusNotFound},
+	ErrorCode_ERR_NICKNAME_INVALID:         {codes.InvalidArgument, http.StatusUnprocessableEntity},
+	ErrorCode_ERR_AVATAR_INVALID:           {codes.InvalidArgument, http.StatusUnprocessableEntity},
+	ErrorCode_ERR_BIO_INVALID:              {codes.InvalidArgument, http.StatusUnprocessableEntity},
+	ErrorCode_ERR_LEADERBOARD_NOT_FOUND:    {codes.NotFound, http.StatusNotFound},
+	ErrorCode_ERR_SCORE_INVALID:            {codes.InvalidArgument, http.StatusUnprocessableEntity},
+	ErrorCode_ERR_LEADERBOARD_NOT_RANKED:   {codes.NotFound, http.StatusNotFound},
+	ErrorCode_ERR_MATCH_REGION_INVALID:     {codes.InvalidArgument, http.StatusUnprocessableEntity},
+	ErrorCode_ERR_MATCH_PARTY_SIZE_INVALID: {codes.InvalidArgument, http.StatusUnprocessableEntity},
+	ErrorCode_ERR_MATCH_QUEUED:             {codes.AlreadyExists, http.StatusConflict},
+	ErrorCode_ERR_MATCH_NOT_QUEUED:         {codes.NotFound, http.StatusNotFound},
+	ErrorCode_ERR_ROOM_NOT_FOUND:           {codes.NotFound, http.StatusNotFound},
+	ErrorCode_ERR_ROOM_FULL:                {codes.ResourceExhausted, http.StatusConflict},
+	ErrorCode_ERR_ROOM_STARTED:             {codes.FailedPrecondition, http.StatusConflict},
+	ErrorCode_ERR_ROOM_JOINED:              {codes.AlreadyExists, http.StatusConflict},
+	ErrorCode_ERR_ROOM_NOT_JOINED:          {codes.FailedPrecondition, http.StatusConflict},
+}
+
+// Grpc code without Error detail, map to general error code
+var grpcErrorCodes = map[codes.Code]ErrorCode{
+	codes.Canceled:          ErrorCode_ERR_TIMEOUT,
+	codes.InvalidArgument:   ErrorCode_ERR_INVALID_ARGUMENT,
+	codes.DeadlineExceeded:  ErrorCode_ERR_TIMEOUT,
+	codes.NotFound:          ErrorCode_ERR_NOT_FOUND,
+	codes.AlreadyExists:     ErrorCode_ERR_ALREADY_EXISTS,
+	codes.PermissionDenied:  ErrorCode_ERR_PERMISSION_DENIED,
+	codes.ResourceExhausted: ErrorCode_ERR_TOO_MANY_REQUESTS,
+	codes.Unimplemented:     ErrorCode_ERR_UNKNOWN_REQUEST,
+	codes.Internal:          ErrorCode_ERR_INTERNAL,
+	codes.Unavailable:       ErrorCode_ERR_UNAVAILABLE,
+	codes.Unauthenticated:   ErrorCode_ERR_UNAUTHENTICATED,
+}
+
+func NewError(code ErrorCode, message string) *Error {
+	return &Error{
+		Code:    code,
+		Message: message,
+	}
+}
+
+func (m *Error) Error() string {
+	return m.GetMessage()
+}
+
+// Grpc server use it to make status, with Error as detail
+func (m *Error) GRPCStatus() *status.Status {
+	s := status.New(errorStatuses[m.GetCode()].grpc, m.GetMessage())
+	if ds, err := s.WithDetails(m); err == nil {
+		return ds
+	}
+	return s
+}
+
+func (m *Error) HTTPStatus() int {
+	if st, ok := errorStatuses[m.GetCode()]; ok {
+		return st.http
+	}
+	return http.StatusInternalServerError
+}
+
+// Convert any error to Error, unknown error message is hidden from client
+func ToError(err error) *Error {
+	if e, ok := err.(*Error); ok {
+		return e
+	}
+
+	if s, ok := status.FromError(err); ok {
+		for _, detail := range s.Details() {
+			if e, ok := detail.(*Error); ok {
+				return e
+			}
+		}
+		code, ok := grpcErrorCodes[s.Code()]
+		if !ok {
+			code = ErrorCode_ERR_UNKNOWN
+		}
+		return NewError(code, codeMessage(code))
+	}
+
+	return NewError(ErrorCode_ERR_INTERNAL, codeMessage(ErrorCode_ERR_INTERNAL))
+}
+
+// Default message from code name, eg: ERR_TOO_MANY_REQUESTS as "too many requests"
+func codeMessage(code ErrorCode) string {
+	name := strings.TrimPrefix(code.String(), "ERR_")
+	return strings.ToLower(strings.Replace(name, "_", " ", -1))
+}
@@ -19,14 +19,149 @@ func MakeRsp_GetUserInfoRsp(mid string, user *User) *Message {
 	})
 }
 
-func MakeRsp_Error(mid, message string) *Message {
-	return MakeRsp(mid, &Rsp_Error{
-		Error: &Error{
-			Message: message,
+func MakeRsp_JoinChannelRsp(mid, channel string) *Message {
+	return MakeRsp(mid, &Rsp_JoinChannelRsp{
+		JoinChannelRsp: &JoinChannelRsp{
+			Channel: channel,
+		},
+	})
+}
+
+func MakeRsp_LeaveChannelRsp(mid, channel string) *Message {
+	return MakeRsp(mid, &Rsp_LeaveChannelRsp{
+		LeaveChannelRsp: &LeaveChannelRsp{
+			Channel: channel,
+		},
+	})
+}
+
+func MakeRsp_GetChatHistoryRsp(mid string, rsp *GetChatHistoryRsp) *Message {
+	return MakeRsp(mid, &Rsp_GetChatHistoryRsp{
+		GetChatHistoryRsp: rsp,
+	})
+}
+
+func MakeRsp_SendFriendRsp(mid, uid string) *Message {
+	return MakeRsp(mid, &Rsp_SendFriendRsp{
+		SendFriendRsp: &SendFriendRsp{
+			Uid: uid,
+		},
+	})
+}
+
+func MakeRsp_AcceptFriendRsp(mid string, friend *Friend) *Message {
+	return MakeRsp(mid, &Rsp_AcceptFriendRsp{
+		AcceptFriendRsp: &AcceptFriendRsp{
+			Friend: friend,
+		},
+	})
+}
+
+func MakeRsp_DeclineFriendRsp(mid, uid string) *Message {
+	return MakeRsp(mid, &Rsp_DeclineFriendRsp{
+		DeclineFriendRsp: &DeclineFriendRsp{
+			Uid: uid,
+		},
+	})
+}
+
+func MakeRsp_RemoveFriendRsp(mid, uid string) *Message {
+	return MakeRsp(mid, &Rsp_RemoveFriendRsp{
+		RemoveFriendRsp: &RemoveFriendRsp{
+			Uid: uid,
+		},
+	})
+}
+
+func MakeRsp_GetFriendListRsp(mid string, rsp *GetFriendListRsp) *Message {
+	return MakeRsp(mid, &Rsp_GetFriendListRsp{
+		GetFriendListRsp: rsp,
+	})
+}
+
+func MakeRsp_GetProfileRsp(mid string, profile *Profile) *Message {
+	return MakeRsp(mid, &Rsp_GetProfileRsp{
+		GetProfileRsp: &GetProfileRsp{
+			Profile: profile,
 		},
 	})
 }
 
+func MakeRsp_UpdateProfileRsp(mid string, profile *Profile) *Message {
+	return MakeRsp(mid, &Rsp_UpdateProfileRsp{
+		UpdateProfileRsp: &UpdateProfileRsp{
+			Profile: profile,
+		},
+	})
+}
+
+func MakeRsp_SubmitScoreRsp(mid string, entry *LeaderboardEntry) *Message {
+	return MakeRsp(mid, &Rsp_SubmitScoreRsp{
+		SubmitScoreRsp: &SubmitScoreRsp{
+			Entry: entry,
+		},
+	})
+}
+
+func MakeRsp_GetLeaderboardRsp(mid string, leaderboard *Leaderboard) *Message {
+	return MakeRsp(mid, &Rsp_GetLeaderboardRsp{
+		GetLeaderboardRsp: &GetLeaderboardRsp{
+			Leaderboard: leaderboard,
+		},
+	})
+}
+
+func MakeRsp_JoinMatchRsp(mid string) *Message {
+	return MakeRsp(mid, &Rsp_JoinMatchRsp{
+		JoinMatchRsp: &JoinMatchRsp{},
+	})
+}
+
+func MakeRsp_CancelMatchRsp(mid string) *Message {
+	return MakeRsp(mid, &Rsp_CancelMatchRsp{
+		CancelMatchRsp: &CancelMatchRsp{},
+	})
+}
+
+func MakeRsp_CreateRoomRsp(mid string, room *RoomInfo) *Message {
+	return MakeRsp(mid, &Rsp_CreateRoomRsp{
+		CreateRoomRsp: &CreateRoomRsp{
+			Room: room,
+		},
+	})
+}
+
+func MakeRsp_JoinRoomRsp(mid string, room *RoomInfo) *Message {
+	return MakeRsp(mid, &Rsp_JoinRoomRsp{
+		JoinRoomRsp: &JoinRoomRsp{
+			Room: room,
+		},
+	})
+}
+
+func MakeRsp_LeaveRoomRsp(mid, roomId string) *Message {
+	return MakeRsp(mid, &Rsp_LeaveRoomRsp{
+		LeaveRoomRsp: &LeaveRoomRsp{
+			RoomId: roomId,
+		},
+	})
+}
+
+func MakeRsp_ReadyRoomRsp(mid string, ready bool) *Message {
+	return MakeRsp(mid, &Rsp_ReadyRoomRsp{
+		ReadyRoomRsp: &ReadyRoomRsp{
+			Ready: ready,
+		},
+	})
+}
+
+// Any error is converted by ToError
+func MakeRsp_Error(mid string, err error) *Message {
+	return MakeRsp(mid, &Rsp_Error{
+		Error: ToError(err),
+	})
+}
+
 func MakePush(push isPush_Push) *Message {
 	return &Message{
 		Message: &Message_Push{
@@ -37,10 +172,70 @@ func MakePush(push isPush_Push) *Message {
 	}
 }
 
-func MakePush_ChatPush(message string) *Message {
+func MakePush_ChatPush(push *ChatPush) *Message {
 	return MakePush(&Push_ChatPush{
-		ChatPush: &ChatPush{
-			Message: message,
+		ChatPush: push,
+	})
+}
+
+func MakePush_SystemPush(push *SystemPush) *Message {
+	return MakePush(&Push_SystemPush{
+		SystemPush: push,
+	})
+}
+
+func MakePush_FriendRequestPush(from *Friend) *Message {
+	return MakePush(&Push_FriendRequestPush{
+		FriendRequestPush: &FriendRequestPush{
+			From: from,
 		},
 	})
 }
+
+func MakePush_FriendAcceptPush(friend *Friend) *Message {
+	return MakePush(&Push_FriendAcceptPush{
+		FriendAcceptPush: &FriendAcceptPush{
+			Friend: friend,
+		},
+	})
+}
+
+func MakePush_FriendPresencePush(friend *Friend) *Message {
+	return MakePush(&Push_FriendPresencePush{
+		FriendPresencePush: &FriendPresencePush{
+			Friend: friend,
+		},
+	})
+}
+
+func MakePush_DirectMessagePush(push *DirectMessagePush) *Message {
+	return MakePush(&Push_DirectMessagePush{
+		DirectMessagePush: push,
+	})
+}
+
+func MakePush_MatchFoundPush(push *MatchFoundPush) *Message {
+	return MakePush(&Push_MatchFoundPush{
+		MatchFoundPush: push,
+	})
+}
+
+func MakePush_RoomUpdatePush(room *RoomInfo) *Message {
+	return MakePush(&Push_RoomUpdatePush{
+		RoomUpdatePush: &RoomUpdatePush{
+			Room: room,
+		},
+	})
+}
+
+func MakePush_RoomSnapshotPush(push *RoomSnapshotPush) *Message {
+	return MakePush(&Push_RoomSnapshotPush{
+		RoomSnapshotPush: push,
+	})
+}
+
+func MakePush_SessionPush(push *SessionPush) *Message {
+	return MakePush(&Push_SessionPush{
+		SessionPush: push,
+	})
+}
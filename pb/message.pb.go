@@ -24,6 +24,152 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// Error code shared by ws protocol, http api and grpc service
+type ErrorCode int32
+
+const (
+	ErrorCode_ERR_UNKNOWN                  ErrorCode = 0
+	ErrorCode_ERR_INTERNAL                 ErrorCode = 1
+	ErrorCode_ERR_INVALID_ARGUMENT         ErrorCode = 2
+	ErrorCode_ERR_NOT_FOUND                ErrorCode = 3
+	ErrorCode_ERR_ALREADY_EXISTS           ErrorCode = 4
+	ErrorCode_ERR_UNAUTHENTICATED          ErrorCode = 5
+	ErrorCode_ERR_PERMISSION_DENIED        ErrorCode = 6
+	ErrorCode_ERR_TOO_MANY_REQUESTS        ErrorCode = 7
+	ErrorCode_ERR_TIMEOUT                  ErrorCode = 8
+	ErrorCode_ERR_UNAVAILABLE              ErrorCode = 9
+	ErrorCode_ERR_UNKNOWN_REQUEST          ErrorCode = 10
+	ErrorCode_ERR_EMAIL_INVALID            ErrorCode = 100
+	ErrorCode_ERR_EMAIL_EXISTED            ErrorCode = 101
+	ErrorCode_ERR_EMAIL_NOT_EXIST          ErrorCode = 102
+	ErrorCode_ERR_PASSWORD_INVALID         ErrorCode = 103
+	ErrorCode_ERR_PASSWORD_WRONG           ErrorCode = 104
+	ErrorCode_ERR_TOKEN_MISSING            ErrorCode = 105
+	ErrorCode_ERR_TOKEN_INVALID            ErrorCode = 106
+	ErrorCode_ERR_USER_NOT_FOUND           ErrorCode = 107
+	ErrorCode_ERR_CHANNEL_INVALID          ErrorCode = 108
+	ErrorCode_ERR_CHANNEL_NOT_JOINED       ErrorCode = 109
+	ErrorCode_ERR_CURSOR_INVALID           ErrorCode = 110
+	ErrorCode_ERR_FRIEND_SELF              ErrorCode = 111
+	ErrorCode_ERR_FRIEND_EXISTED           ErrorCode = 112
+	ErrorCode_ERR_FRIEND_REQUEST_EXISTED   ErrorCode = 113
+	ErrorCode_ERR_FRIEND_REQUEST_NOT_FOUND ErrorCode = 114
+	ErrorCode_ERR_FRIEND_NOT_FOUND         ErrorCode = 115
+	ErrorCode_ERR_NICKNAME_INVALID         ErrorCode = 116
+	ErrorCode_ERR_AVATAR_INVALID           ErrorCode = 117
+	ErrorCode_ERR_BIO_INVALID              ErrorCode = 118
+	ErrorCode_ERR_LEADERBOARD_NOT_FOUND    ErrorCode = 119
+	ErrorCode_ERR_SCORE_INVALID            ErrorCode = 120
+	ErrorCode_ERR_LEADERBOARD_NOT_RANKED   ErrorCode = 121
+	ErrorCode_ERR_MATCH_REGION_INVALID     ErrorCode = 122
+	ErrorCode_ERR_MATCH_PARTY_SIZE_INVALID ErrorCode = 123
+	ErrorCode_ERR_MATCH_QUEUED             ErrorCode = 124
+	ErrorCode_ERR_MATCH_NOT_QUEUED         ErrorCode = 125
+	ErrorCode_ERR_ROOM_NOT_FOUND           ErrorCode = 126
+	ErrorCode_ERR_ROOM_FULL                ErrorCode = 127
+	ErrorCode_ERR_ROOM_STARTED             ErrorCode = 128
+	ErrorCode_ERR_ROOM_JOINED              ErrorCode = 129
+	ErrorCode_ERR_ROOM_NOT_JOINED          ErrorCode = 130
+)
+
+var ErrorCode_name = map[int32]string{
+	0:   "ERR_UNKNOWN",
+	1:   "ERR_INTERNAL",
+	2:   "ERR_INVALID_ARGUMENT",
+	3:   "ERR_NOT_FOUND",
+	4:   "ERR_ALREADY_EXISTS",
+	5:   "ERR_UNAUTHENTICATED",
+	6:   "ERR_PERMISSION_DENIED",
+	7:   "ERR_TOO_MANY_REQUESTS",
+	8:   "ERR_TIMEOUT",
+	9:   "ERR_UNAVAILABLE",
+	10:  "ERR_UNKNOWN_REQUEST",
+	100: "ERR_EMAIL_INVALID",
+	101: "ERR_EMAIL_EXISTED",
+	102: "ERR_EMAIL_NOT_EXIST",
+	103: "ERR_PASSWORD_INVALID",
+	104: "ERR_PASSWORD_WRONG",
+	105: "ERR_TOKEN_MISSING",
+	106: "ERR_TOKEN_INVALID",
+	107: "ERR_USER_NOT_FOUND",
+	108: "ERR_CHANNEL_INVALID",
+	109: "ERR_CHANNEL_NOT_JOINED",
+	110: "ERR_CURSOR_INVALID",
+	111: "ERR_FRIEND_SELF",
+	112: "ERR_FRIEND_EXISTED",
+	113: "ERR_FRIEND_REQUEST_EXISTED",
+	114: "ERR_FRIEND_REQUEST_NOT_FOUND",
+	115: "ERR_FRIEND_NOT_FOUND",
+	116: "ERR_NICKNAME_INVALID",
+	117: "ERR_AVATAR_INVALID",
+	118: "ERR_BIO_INVALID",
+	119: "ERR_LEADERBOARD_NOT_FOUND",
+	120: "ERR_SCORE_INVALID",
+	121: "ERR_LEADERBOARD_NOT_RANKED",
+	122: "ERR_MATCH_REGION_INVALID",
+	123: "ERR_MATCH_PARTY_SIZE_INVALID",
+	124: "ERR_MATCH_QUEUED",
+	125: "ERR_MATCH_NOT_QUEUED",
+	126: "ERR_ROOM_NOT_FOUND",
+	127: "ERR_ROOM_FULL",
+	128: "ERR_ROOM_STARTED",
+	129: "ERR_ROOM_JOINED",
+	130: "ERR_ROOM_NOT_JOINED",
+}
+
+var ErrorCode_value = map[string]int32{
+	"ERR_UNKNOWN":                  0,
+	"ERR_INTERNAL":                 1,
+	"ERR_INVALID_ARGUMENT":         2,
+	"ERR_NOT_FOUND":                3,
+	"ERR_ALREADY_EXISTS":           4,
+	"ERR_UNAUTHENTICATED":          5,
+	"ERR_PERMISSION_DENIED":        6,
+	"ERR_TOO_MANY_REQUESTS":        7,
+	"ERR_TIMEOUT":                  8,
+	"ERR_UNAVAILABLE":              9,
+	"ERR_UNKNOWN_REQUEST":          10,
+	"ERR_EMAIL_INVALID":            100,
+	"ERR_EMAIL_EXISTED":            101,
+	"ERR_EMAIL_NOT_EXIST":          102,
+	"ERR_PASSWORD_INVALID":         103,
+	"ERR_PASSWORD_WRONG":           104,
+	"ERR_TOKEN_MISSING":            105,
+	"ERR_TOKEN_INVALID":            106,
+	"ERR_USER_NOT_FOUND":           107,
+	"ERR_CHANNEL_INVALID":          108,
+	"ERR_CHANNEL_NOT_JOINED":       109,
+	"ERR_CURSOR_INVALID":           110,
+	"ERR_FRIEND_SELF":              111,
+	"ERR_FRIEND_EXISTED":           112,
+	"ERR_FRIEND_REQUEST_EXISTED":   113,
+	"ERR_FRIEND_REQUEST_NOT_FOUND": 114,
+	"ERR_FRIEND_NOT_FOUND":         115,
+	"ERR_NICKNAME_INVALID":         116,
+	"ERR_AVATAR_INVALID":           117,
+	"ERR_BIO_INVALID":              118,
+	"ERR_LEADERBOARD_NOT_FOUND":    119,
+	"ERR_SCORE_INVALID":            120,
+	"ERR_LEADERBOARD_NOT_RANKED":   121,
+	"ERR_MATCH_REGION_INVALID":     122,
+	"ERR_MATCH_PARTY_SIZE_INVALID": 123,
+	"ERR_MATCH_QUEUED":             124,
+	"ERR_MATCH_NOT_QUEUED":         125,
+	"ERR_ROOM_NOT_FOUND":           126,
+	"ERR_ROOM_FULL":                127,
+	"ERR_ROOM_STARTED":             128,
+	"ERR_ROOM_JOINED":              129,
+	"ERR_ROOM_NOT_JOINED":          130,
+}
+
+func (x ErrorCode) String() string {
+	return proto.EnumName(ErrorCode_name, int32(x))
+}
+
+func (ErrorCode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{0}
+}
+
 type Message struct {
 	// Types that are valid to be assigned to Message:
 	//	*Message_Req
@@ -138,6 +284,24 @@ type Req struct {
 	Mid string `protobuf:"bytes,1,opt,name=mid,proto3" json:"mid,omitempty"`
 	// Types that are valid to be assigned to Req:
 	//	*Req_GetUserInfoReq
+	//	*Req_JoinChannelReq
+	//	*Req_LeaveChannelReq
+	//	*Req_GetChatHistoryReq
+	//	*Req_SendFriendReq
+	//	*Req_AcceptFriendReq
+	//	*Req_DeclineFriendReq
+	//	*Req_RemoveFriendReq
+	//	*Req_GetFriendListReq
+	//	*Req_GetProfileReq
+	//	*Req_UpdateProfileReq
+	//	*Req_SubmitScoreReq
+	//	*Req_GetLeaderboardReq
+	//	*Req_JoinMatchReq
+	//	*Req_CancelMatchReq
+	//	*Req_CreateRoomReq
+	//	*Req_JoinRoomReq
+	//	*Req_LeaveRoomReq
+	//	*Req_ReadyRoomReq
 	Req                  isReq_Req `protobuf_oneof:"req"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
 	XXX_unrecognized     []byte    `json:"-"`
@@ -184,8 +348,116 @@ type Req_GetUserInfoReq struct {
 	GetUserInfoReq *GetUserInfoReq `protobuf:"bytes,2,opt,name=getUserInfoReq,proto3,oneof"`
 }
 
+type Req_JoinChannelReq struct {
+	JoinChannelReq *JoinChannelReq `protobuf:"bytes,3,opt,name=joinChannelReq,proto3,oneof"`
+}
+
+type Req_LeaveChannelReq struct {
+	LeaveChannelReq *LeaveChannelReq `protobuf:"bytes,4,opt,name=leaveChannelReq,proto3,oneof"`
+}
+
+type Req_GetChatHistoryReq struct {
+	GetChatHistoryReq *GetChatHistoryReq `protobuf:"bytes,5,opt,name=getChatHistoryReq,proto3,oneof"`
+}
+
+type Req_SendFriendReq struct {
+	SendFriendReq *SendFriendReq `protobuf:"bytes,6,opt,name=sendFriendReq,proto3,oneof"`
+}
+
+type Req_AcceptFriendReq struct {
+	AcceptFriendReq *AcceptFriendReq `protobuf:"bytes,7,opt,name=acceptFriendReq,proto3,oneof"`
+}
+
+type Req_DeclineFriendReq struct {
+	DeclineFriendReq *DeclineFriendReq `protobuf:"bytes,8,opt,name=declineFriendReq,proto3,oneof"`
+}
+
+type Req_RemoveFriendReq struct {
+	RemoveFriendReq *RemoveFriendReq `protobuf:"bytes,9,opt,name=removeFriendReq,proto3,oneof"`
+}
+
+type Req_GetFriendListReq struct {
+	GetFriendListReq *GetFriendListReq `protobuf:"bytes,10,opt,name=getFriendListReq,proto3,oneof"`
+}
+
+type Req_GetProfileReq struct {
+	GetProfileReq *GetProfileReq `protobuf:"bytes,11,opt,name=getProfileReq,proto3,oneof"`
+}
+
+type Req_UpdateProfileReq struct {
+	UpdateProfileReq *UpdateProfileReq `protobuf:"bytes,12,opt,name=updateProfileReq,proto3,oneof"`
+}
+
+type Req_SubmitScoreReq struct {
+	SubmitScoreReq *SubmitScoreReq `protobuf:"bytes,13,opt,name=submitScoreReq,proto3,oneof"`
+}
+
+type Req_GetLeaderboardReq struct {
+	GetLeaderboardReq *GetLeaderboardReq `protobuf:"bytes,14,opt,name=getLeaderboardReq,proto3,oneof"`
+}
+
+type Req_JoinMatchReq struct {
+	JoinMatchReq *JoinMatchReq `protobuf:"bytes,15,opt,name=joinMatchReq,proto3,oneof"`
+}
+
+type Req_CancelMatchReq struct {
+	CancelMatchReq *CancelMatchReq `protobuf:"bytes,16,opt,name=cancelMatchReq,proto3,oneof"`
+}
+
+type Req_CreateRoomReq struct {
+	CreateRoomReq *CreateRoomReq `protobuf:"bytes,17,opt,name=createRoomReq,proto3,oneof"`
+}
+
+type Req_JoinRoomReq struct {
+	JoinRoomReq *JoinRoomReq `protobuf:"bytes,18,opt,name=joinRoomReq,proto3,oneof"`
+}
+
+type Req_LeaveRoomReq struct {
+	LeaveRoomReq *LeaveRoomReq `protobuf:"bytes,19,opt,name=leaveRoomReq,proto3,oneof"`
+}
+
+type Req_ReadyRoomReq struct {
+	ReadyRoomReq *ReadyRoomReq `protobuf:"bytes,20,opt,name=readyRoomReq,proto3,oneof"`
+}
+
 func (*Req_GetUserInfoReq) isReq_Req() {}
 
+func (*Req_JoinChannelReq) isReq_Req() {}
+
+func (*Req_LeaveChannelReq) isReq_Req() {}
+
+func (*Req_GetChatHistoryReq) isReq_Req() {}
+
+func (*Req_SendFriendReq) isReq_Req() {}
+
+func (*Req_AcceptFriendReq) isReq_Req() {}
+
+func (*Req_DeclineFriendReq) isReq_Req() {}
+
+func (*Req_RemoveFriendReq) isReq_Req() {}
+
+func (*Req_GetFriendListReq) isReq_Req() {}
+
+func (*Req_GetProfileReq) isReq_Req() {}
+
+func (*Req_UpdateProfileReq) isReq_Req() {}
+
+func (*Req_SubmitScoreReq) isReq_Req() {}
+
+func (*Req_GetLeaderboardReq) isReq_Req() {}
+
+func (*Req_JoinMatchReq) isReq_Req() {}
+
+func (*Req_CancelMatchReq) isReq_Req() {}
+
+func (*Req_CreateRoomReq) isReq_Req() {}
+
+func (*Req_JoinRoomReq) isReq_Req() {}
+
+func (*Req_LeaveRoomReq) isReq_Req() {}
+
+func (*Req_ReadyRoomReq) isReq_Req() {}
+
 func (m *Req) GetReq() isReq_Req {
 	if m != nil {
 		return m.Req
@@ -200,10 +472,154 @@ func (m *Req) GetGetUserInfoReq() *GetUserInfoReq {
 	return nil
 }
 
+func (m *Req) GetJoinChannelReq() *JoinChannelReq {
+	if x, ok := m.GetReq().(*Req_JoinChannelReq); ok {
+		return x.JoinChannelReq
+	}
+	return nil
+}
+
+func (m *Req) GetLeaveChannelReq() *LeaveChannelReq {
+	if x, ok := m.GetReq().(*Req_LeaveChannelReq); ok {
+		return x.LeaveChannelReq
+	}
+	return nil
+}
+
+func (m *Req) GetGetChatHistoryReq() *GetChatHistoryReq {
+	if x, ok := m.GetReq().(*Req_GetChatHistoryReq); ok {
+		return x.GetChatHistoryReq
+	}
+	return nil
+}
+
+func (m *Req) GetSendFriendReq() *SendFriendReq {
+	if x, ok := m.GetReq().(*Req_SendFriendReq); ok {
+		return x.SendFriendReq
+	}
+	return nil
+}
+
+func (m *Req) GetAcceptFriendReq() *AcceptFriendReq {
+	if x, ok := m.GetReq().(*Req_AcceptFriendReq); ok {
+		return x.AcceptFriendReq
+	}
+	return nil
+}
+
+func (m *Req) GetDeclineFriendReq() *DeclineFriendReq {
+	if x, ok := m.GetReq().(*Req_DeclineFriendReq); ok {
+		return x.DeclineFriendReq
+	}
+	return nil
+}
+
+func (m *Req) GetRemoveFriendReq() *RemoveFriendReq {
+	if x, ok := m.GetReq().(*Req_RemoveFriendReq); ok {
+		return x.RemoveFriendReq
+	}
+	return nil
+}
+
+func (m *Req) GetGetFriendListReq() *GetFriendListReq {
+	if x, ok := m.GetReq().(*Req_GetFriendListReq); ok {
+		return x.GetFriendListReq
+	}
+	return nil
+}
+
+func (m *Req) GetGetProfileReq() *GetProfileReq {
+	if x, ok := m.GetReq().(*Req_GetProfileReq); ok {
+		return x.GetProfileReq
+	}
+	return nil
+}
+
+func (m *Req) GetUpdateProfileReq() *UpdateProfileReq {
+	if x, ok := m.GetReq().(*Req_UpdateProfileReq); ok {
+		return x.UpdateProfileReq
+	}
+	return nil
+}
+
+func (m *Req) GetSubmitScoreReq() *SubmitScoreReq {
+	if x, ok := m.GetReq().(*Req_SubmitScoreReq); ok {
+		return x.SubmitScoreReq
+	}
+	return nil
+}
+
+func (m *Req) GetGetLeaderboardReq() *GetLeaderboardReq {
+	if x, ok := m.GetReq().(*Req_GetLeaderboardReq); ok {
+		return x.GetLeaderboardReq
+	}
+	return nil
+}
+
+func (m *Req) GetJoinMatchReq() *JoinMatchReq {
+	if x, ok := m.GetReq().(*Req_JoinMatchReq); ok {
+		return x.JoinMatchReq
+	}
+	return nil
+}
+
+func (m *Req) GetCancelMatchReq() *CancelMatchReq {
+	if x, ok := m.GetReq().(*Req_CancelMatchReq); ok {
+		return x.CancelMatchReq
+	}
+	return nil
+}
+
+func (m *Req) GetCreateRoomReq() *CreateRoomReq {
+	if x, ok := m.GetReq().(*Req_CreateRoomReq); ok {
+		return x.CreateRoomReq
+	}
+	return nil
+}
+
+func (m *Req) GetJoinRoomReq() *JoinRoomReq {
+	if x, ok := m.GetReq().(*Req_JoinRoomReq); ok {
+		return x.JoinRoomReq
+	}
+	return nil
+}
+
+func (m *Req) GetLeaveRoomReq() *LeaveRoomReq {
+	if x, ok := m.GetReq().(*Req_LeaveRoomReq); ok {
+		return x.LeaveRoomReq
+	}
+	return nil
+}
+
+func (m *Req) GetReadyRoomReq() *ReadyRoomReq {
+	if x, ok := m.GetReq().(*Req_ReadyRoomReq); ok {
+		return x.ReadyRoomReq
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Req) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*Req_GetUserInfoReq)(nil),
+		(*Req_JoinChannelReq)(nil),
+		(*Req_LeaveChannelReq)(nil),
+		(*Req_GetChatHistoryReq)(nil),
+		(*Req_SendFriendReq)(nil),
+		(*Req_AcceptFriendReq)(nil),
+		(*Req_DeclineFriendReq)(nil),
+		(*Req_RemoveFriendReq)(nil),
+		(*Req_GetFriendListReq)(nil),
+		(*Req_GetProfileReq)(nil),
+		(*Req_UpdateProfileReq)(nil),
+		(*Req_SubmitScoreReq)(nil),
+		(*Req_GetLeaderboardReq)(nil),
+		(*Req_JoinMatchReq)(nil),
+		(*Req_CancelMatchReq)(nil),
+		(*Req_CreateRoomReq)(nil),
+		(*Req_JoinRoomReq)(nil),
+		(*Req_LeaveRoomReq)(nil),
+		(*Req_ReadyRoomReq)(nil),
 	}
 }
 
@@ -238,530 +654,4162 @@ func (m *GetUserInfoReq) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetUserInfoReq proto.InternalMessageInfo
 
-type Rsp struct {
-	Mid string `protobuf:"bytes,1,opt,name=mid,proto3" json:"mid,omitempty"`
-	// Types that are valid to be assigned to Rsp:
-	//	*Rsp_Error
-	//	*Rsp_GetUserInfoRsp
-	Rsp                  isRsp_Rsp `protobuf_oneof:"rsp"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+type JoinChannelReq struct {
+	Channel              string   `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Rsp) Reset()         { *m = Rsp{} }
-func (m *Rsp) String() string { return proto.CompactTextString(m) }
-func (*Rsp) ProtoMessage()    {}
-func (*Rsp) Descriptor() ([]byte, []int) {
+func (m *JoinChannelReq) Reset()         { *m = JoinChannelReq{} }
+func (m *JoinChannelReq) String() string { return proto.CompactTextString(m) }
+func (*JoinChannelReq) ProtoMessage()    {}
+func (*JoinChannelReq) Descriptor() ([]byte, []int) {
 	return fileDescriptor_33c57e4bae7b9afd, []int{3}
 }
 
-func (m *Rsp) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Rsp.Unmarshal(m, b)
+func (m *JoinChannelReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JoinChannelReq.Unmarshal(m, b)
 }
-func (m *Rsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Rsp.Marshal(b, m, deterministic)
+func (m *JoinChannelReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JoinChannelReq.Marshal(b, m, deterministic)
 }
-func (m *Rsp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Rsp.Merge(m, src)
+func (m *JoinChannelReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JoinChannelReq.Merge(m, src)
 }
-func (m *Rsp) XXX_Size() int {
-	return xxx_messageInfo_Rsp.Size(m)
+func (m *JoinChannelReq) XXX_Size() int {
+	return xxx_messageInfo_JoinChannelReq.Size(m)
 }
-func (m *Rsp) XXX_DiscardUnknown() {
-	xxx_messageInfo_Rsp.DiscardUnknown(m)
+func (m *JoinChannelReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_JoinChannelReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Rsp proto.InternalMessageInfo
+var xxx_messageInfo_JoinChannelReq proto.InternalMessageInfo
 
-func (m *Rsp) GetMid() string {
+func (m *JoinChannelReq) GetChannel() string {
 	if m != nil {
-		return m.Mid
+		return m.Channel
 	}
 	return ""
 }
 
-type isRsp_Rsp interface {
-	isRsp_Rsp()
+type LeaveChannelReq struct {
+	Channel              string   `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type Rsp_Error struct {
-	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+func (m *LeaveChannelReq) Reset()         { *m = LeaveChannelReq{} }
+func (m *LeaveChannelReq) String() string { return proto.CompactTextString(m) }
+func (*LeaveChannelReq) ProtoMessage()    {}
+func (*LeaveChannelReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{4}
 }
 
-type Rsp_GetUserInfoRsp struct {
-	GetUserInfoRsp *GetUserInfoRsp `protobuf:"bytes,3,opt,name=getUserInfoRsp,proto3,oneof"`
+func (m *LeaveChannelReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeaveChannelReq.Unmarshal(m, b)
+}
+func (m *LeaveChannelReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeaveChannelReq.Marshal(b, m, deterministic)
+}
+func (m *LeaveChannelReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaveChannelReq.Merge(m, src)
+}
+func (m *LeaveChannelReq) XXX_Size() int {
+	return xxx_messageInfo_LeaveChannelReq.Size(m)
+}
+func (m *LeaveChannelReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaveChannelReq.DiscardUnknown(m)
 }
 
-func (*Rsp_Error) isRsp_Rsp() {}
-
-func (*Rsp_GetUserInfoRsp) isRsp_Rsp() {}
+var xxx_messageInfo_LeaveChannelReq proto.InternalMessageInfo
 
-func (m *Rsp) GetRsp() isRsp_Rsp {
+func (m *LeaveChannelReq) GetChannel() string {
 	if m != nil {
-		return m.Rsp
+		return m.Channel
 	}
-	return nil
+	return ""
 }
 
-func (m *Rsp) GetError() *Error {
-	if x, ok := m.GetRsp().(*Rsp_Error); ok {
-		return x.Error
+type GetChatHistoryReq struct {
+	Channel              string   `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Before               string   `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	Limit                int32    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetChatHistoryReq) Reset()         { *m = GetChatHistoryReq{} }
+func (m *GetChatHistoryReq) String() string { return proto.CompactTextString(m) }
+func (*GetChatHistoryReq) ProtoMessage()    {}
+func (*GetChatHistoryReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{5}
+}
+
+func (m *GetChatHistoryReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetChatHistoryReq.Unmarshal(m, b)
+}
+func (m *GetChatHistoryReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetChatHistoryReq.Marshal(b, m, deterministic)
+}
+func (m *GetChatHistoryReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetChatHistoryReq.Merge(m, src)
+}
+func (m *GetChatHistoryReq) XXX_Size() int {
+	return xxx_messageInfo_GetChatHistoryReq.Size(m)
+}
+func (m *GetChatHistoryReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetChatHistoryReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetChatHistoryReq proto.InternalMessageInfo
+
+func (m *GetChatHistoryReq) GetChannel() string {
+	if m != nil {
+		return m.Channel
 	}
-	return nil
+	return ""
 }
 
-func (m *Rsp) GetGetUserInfoRsp() *GetUserInfoRsp {
-	if x, ok := m.GetRsp().(*Rsp_GetUserInfoRsp); ok {
-		return x.GetUserInfoRsp
+func (m *GetChatHistoryReq) GetBefore() string {
+	if m != nil {
+		return m.Before
 	}
-	return nil
+	return ""
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*Rsp) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*Rsp_Error)(nil),
-		(*Rsp_GetUserInfoRsp)(nil),
+func (m *GetChatHistoryReq) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
 	}
+	return 0
 }
 
-type Error struct {
-	Message              string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+type SendFriendReq struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Error) Reset()         { *m = Error{} }
-func (m *Error) String() string { return proto.CompactTextString(m) }
-func (*Error) ProtoMessage()    {}
-func (*Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33c57e4bae7b9afd, []int{4}
+func (m *SendFriendReq) Reset()         { *m = SendFriendReq{} }
+func (m *SendFriendReq) String() string { return proto.CompactTextString(m) }
+func (*SendFriendReq) ProtoMessage()    {}
+func (*SendFriendReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{6}
 }
 
-func (m *Error) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Error.Unmarshal(m, b)
+func (m *SendFriendReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendFriendReq.Unmarshal(m, b)
 }
-func (m *Error) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Error.Marshal(b, m, deterministic)
+func (m *SendFriendReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendFriendReq.Marshal(b, m, deterministic)
 }
-func (m *Error) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Error.Merge(m, src)
+func (m *SendFriendReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendFriendReq.Merge(m, src)
 }
-func (m *Error) XXX_Size() int {
-	return xxx_messageInfo_Error.Size(m)
+func (m *SendFriendReq) XXX_Size() int {
+	return xxx_messageInfo_SendFriendReq.Size(m)
 }
-func (m *Error) XXX_DiscardUnknown() {
-	xxx_messageInfo_Error.DiscardUnknown(m)
+func (m *SendFriendReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendFriendReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Error proto.InternalMessageInfo
+var xxx_messageInfo_SendFriendReq proto.InternalMessageInfo
 
-func (m *Error) GetMessage() string {
+func (m *SendFriendReq) GetUid() string {
 	if m != nil {
-		return m.Message
+		return m.Uid
 	}
 	return ""
 }
 
-type GetUserInfoRsp struct {
-	User                 *User    `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+type AcceptFriendReq struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetUserInfoRsp) Reset()         { *m = GetUserInfoRsp{} }
-func (m *GetUserInfoRsp) String() string { return proto.CompactTextString(m) }
-func (*GetUserInfoRsp) ProtoMessage()    {}
-func (*GetUserInfoRsp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33c57e4bae7b9afd, []int{5}
+func (m *AcceptFriendReq) Reset()         { *m = AcceptFriendReq{} }
+func (m *AcceptFriendReq) String() string { return proto.CompactTextString(m) }
+func (*AcceptFriendReq) ProtoMessage()    {}
+func (*AcceptFriendReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{7}
 }
 
-func (m *GetUserInfoRsp) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetUserInfoRsp.Unmarshal(m, b)
+func (m *AcceptFriendReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AcceptFriendReq.Unmarshal(m, b)
 }
-func (m *GetUserInfoRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetUserInfoRsp.Marshal(b, m, deterministic)
+func (m *AcceptFriendReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AcceptFriendReq.Marshal(b, m, deterministic)
 }
-func (m *GetUserInfoRsp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetUserInfoRsp.Merge(m, src)
+func (m *AcceptFriendReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptFriendReq.Merge(m, src)
+}
+func (m *AcceptFriendReq) XXX_Size() int {
+	return xxx_messageInfo_AcceptFriendReq.Size(m)
+}
+func (m *AcceptFriendReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptFriendReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptFriendReq proto.InternalMessageInfo
+
+func (m *AcceptFriendReq) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type DeclineFriendReq struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeclineFriendReq) Reset()         { *m = DeclineFriendReq{} }
+func (m *DeclineFriendReq) String() string { return proto.CompactTextString(m) }
+func (*DeclineFriendReq) ProtoMessage()    {}
+func (*DeclineFriendReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{8}
+}
+
+func (m *DeclineFriendReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeclineFriendReq.Unmarshal(m, b)
+}
+func (m *DeclineFriendReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeclineFriendReq.Marshal(b, m, deterministic)
+}
+func (m *DeclineFriendReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeclineFriendReq.Merge(m, src)
+}
+func (m *DeclineFriendReq) XXX_Size() int {
+	return xxx_messageInfo_DeclineFriendReq.Size(m)
+}
+func (m *DeclineFriendReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeclineFriendReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeclineFriendReq proto.InternalMessageInfo
+
+func (m *DeclineFriendReq) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type RemoveFriendReq struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveFriendReq) Reset()         { *m = RemoveFriendReq{} }
+func (m *RemoveFriendReq) String() string { return proto.CompactTextString(m) }
+func (*RemoveFriendReq) ProtoMessage()    {}
+func (*RemoveFriendReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{9}
+}
+
+func (m *RemoveFriendReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveFriendReq.Unmarshal(m, b)
+}
+func (m *RemoveFriendReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveFriendReq.Marshal(b, m, deterministic)
+}
+func (m *RemoveFriendReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveFriendReq.Merge(m, src)
+}
+func (m *RemoveFriendReq) XXX_Size() int {
+	return xxx_messageInfo_RemoveFriendReq.Size(m)
+}
+func (m *RemoveFriendReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveFriendReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveFriendReq proto.InternalMessageInfo
+
+func (m *RemoveFriendReq) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type GetFriendListReq struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetFriendListReq) Reset()         { *m = GetFriendListReq{} }
+func (m *GetFriendListReq) String() string { return proto.CompactTextString(m) }
+func (*GetFriendListReq) ProtoMessage()    {}
+func (*GetFriendListReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{10}
+}
+
+func (m *GetFriendListReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFriendListReq.Unmarshal(m, b)
+}
+func (m *GetFriendListReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFriendListReq.Marshal(b, m, deterministic)
+}
+func (m *GetFriendListReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFriendListReq.Merge(m, src)
+}
+func (m *GetFriendListReq) XXX_Size() int {
+	return xxx_messageInfo_GetFriendListReq.Size(m)
+}
+func (m *GetFriendListReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFriendListReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFriendListReq proto.InternalMessageInfo
+
+type GetProfileReq struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetProfileReq) Reset()         { *m = GetProfileReq{} }
+func (m *GetProfileReq) String() string { return proto.CompactTextString(m) }
+func (*GetProfileReq) ProtoMessage()    {}
+func (*GetProfileReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{11}
+}
+
+func (m *GetProfileReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProfileReq.Unmarshal(m, b)
+}
+func (m *GetProfileReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProfileReq.Marshal(b, m, deterministic)
+}
+func (m *GetProfileReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProfileReq.Merge(m, src)
+}
+func (m *GetProfileReq) XXX_Size() int {
+	return xxx_messageInfo_GetProfileReq.Size(m)
+}
+func (m *GetProfileReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProfileReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProfileReq proto.InternalMessageInfo
+
+func (m *GetProfileReq) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type SubmitScoreReq struct {
+	Board                string   `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	Score                int64    `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubmitScoreReq) Reset()         { *m = SubmitScoreReq{} }
+func (m *SubmitScoreReq) String() string { return proto.CompactTextString(m) }
+func (*SubmitScoreReq) ProtoMessage()    {}
+func (*SubmitScoreReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{12}
+}
+
+func (m *SubmitScoreReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubmitScoreReq.Unmarshal(m, b)
+}
+func (m *SubmitScoreReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubmitScoreReq.Marshal(b, m, deterministic)
+}
+func (m *SubmitScoreReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitScoreReq.Merge(m, src)
+}
+func (m *SubmitScoreReq) XXX_Size() int {
+	return xxx_messageInfo_SubmitScoreReq.Size(m)
+}
+func (m *SubmitScoreReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitScoreReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmitScoreReq proto.InternalMessageInfo
+
+func (m *SubmitScoreReq) GetBoard() string {
+	if m != nil {
+		return m.Board
+	}
+	return ""
+}
+
+func (m *SubmitScoreReq) GetScore() int64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+type GetLeaderboardReq struct {
+	Board                string   `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	Limit                int32    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Around               bool     `protobuf:"varint,3,opt,name=around,proto3" json:"around,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetLeaderboardReq) Reset()         { *m = GetLeaderboardReq{} }
+func (m *GetLeaderboardReq) String() string { return proto.CompactTextString(m) }
+func (*GetLeaderboardReq) ProtoMessage()    {}
+func (*GetLeaderboardReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{13}
+}
+
+func (m *GetLeaderboardReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLeaderboardReq.Unmarshal(m, b)
+}
+func (m *GetLeaderboardReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLeaderboardReq.Marshal(b, m, deterministic)
+}
+func (m *GetLeaderboardReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLeaderboardReq.Merge(m, src)
+}
+func (m *GetLeaderboardReq) XXX_Size() int {
+	return xxx_messageInfo_GetLeaderboardReq.Size(m)
+}
+func (m *GetLeaderboardReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLeaderboardReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLeaderboardReq proto.InternalMessageInfo
+
+func (m *GetLeaderboardReq) GetBoard() string {
+	if m != nil {
+		return m.Board
+	}
+	return ""
+}
+
+func (m *GetLeaderboardReq) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetLeaderboardReq) GetAround() bool {
+	if m != nil {
+		return m.Around
+	}
+	return false
+}
+
+// Enqueue self, matched by rating, region and party size
+type JoinMatchReq struct {
+	Region               string   `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	PartySize            int32    `protobuf:"varint,2,opt,name=party_size,json=partySize,proto3" json:"party_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JoinMatchReq) Reset()         { *m = JoinMatchReq{} }
+func (m *JoinMatchReq) String() string { return proto.CompactTextString(m) }
+func (*JoinMatchReq) ProtoMessage()    {}
+func (*JoinMatchReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{14}
+}
+
+func (m *JoinMatchReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JoinMatchReq.Unmarshal(m, b)
+}
+func (m *JoinMatchReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JoinMatchReq.Marshal(b, m, deterministic)
+}
+func (m *JoinMatchReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JoinMatchReq.Merge(m, src)
+}
+func (m *JoinMatchReq) XXX_Size() int {
+	return xxx_messageInfo_JoinMatchReq.Size(m)
+}
+func (m *JoinMatchReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_JoinMatchReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JoinMatchReq proto.InternalMessageInfo
+
+func (m *JoinMatchReq) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *JoinMatchReq) GetPartySize() int32 {
+	if m != nil {
+		return m.PartySize
+	}
+	return 0
+}
+
+type CancelMatchReq struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelMatchReq) Reset()         { *m = CancelMatchReq{} }
+func (m *CancelMatchReq) String() string { return proto.CompactTextString(m) }
+func (*CancelMatchReq) ProtoMessage()    {}
+func (*CancelMatchReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{15}
+}
+
+func (m *CancelMatchReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelMatchReq.Unmarshal(m, b)
+}
+func (m *CancelMatchReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelMatchReq.Marshal(b, m, deterministic)
+}
+func (m *CancelMatchReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelMatchReq.Merge(m, src)
+}
+func (m *CancelMatchReq) XXX_Size() int {
+	return xxx_messageInfo_CancelMatchReq.Size(m)
+}
+func (m *CancelMatchReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelMatchReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelMatchReq proto.InternalMessageInfo
+
+// Room is at gateway connected, creator join it at once
+type CreateRoomReq struct {
+	MaxPlayers           int32    `protobuf:"varint,1,opt,name=max_players,json=maxPlayers,proto3" json:"max_players,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateRoomReq) Reset()         { *m = CreateRoomReq{} }
+func (m *CreateRoomReq) String() string { return proto.CompactTextString(m) }
+func (*CreateRoomReq) ProtoMessage()    {}
+func (*CreateRoomReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{16}
+}
+
+func (m *CreateRoomReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateRoomReq.Unmarshal(m, b)
+}
+func (m *CreateRoomReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateRoomReq.Marshal(b, m, deterministic)
+}
+func (m *CreateRoomReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRoomReq.Merge(m, src)
+}
+func (m *CreateRoomReq) XXX_Size() int {
+	return xxx_messageInfo_CreateRoomReq.Size(m)
+}
+func (m *CreateRoomReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRoomReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateRoomReq proto.InternalMessageInfo
+
+func (m *CreateRoomReq) GetMaxPlayers() int32 {
+	if m != nil {
+		return m.MaxPlayers
+	}
+	return 0
+}
+
+type JoinRoomReq struct {
+	RoomId               string   `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JoinRoomReq) Reset()         { *m = JoinRoomReq{} }
+func (m *JoinRoomReq) String() string { return proto.CompactTextString(m) }
+func (*JoinRoomReq) ProtoMessage()    {}
+func (*JoinRoomReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{17}
+}
+
+func (m *JoinRoomReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JoinRoomReq.Unmarshal(m, b)
+}
+func (m *JoinRoomReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JoinRoomReq.Marshal(b, m, deterministic)
+}
+func (m *JoinRoomReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JoinRoomReq.Merge(m, src)
+}
+func (m *JoinRoomReq) XXX_Size() int {
+	return xxx_messageInfo_JoinRoomReq.Size(m)
+}
+func (m *JoinRoomReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_JoinRoomReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JoinRoomReq proto.InternalMessageInfo
+
+func (m *JoinRoomReq) GetRoomId() string {
+	if m != nil {
+		return m.RoomId
+	}
+	return ""
+}
+
+type LeaveRoomReq struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LeaveRoomReq) Reset()         { *m = LeaveRoomReq{} }
+func (m *LeaveRoomReq) String() string { return proto.CompactTextString(m) }
+func (*LeaveRoomReq) ProtoMessage()    {}
+func (*LeaveRoomReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{18}
+}
+
+func (m *LeaveRoomReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeaveRoomReq.Unmarshal(m, b)
+}
+func (m *LeaveRoomReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeaveRoomReq.Marshal(b, m, deterministic)
+}
+func (m *LeaveRoomReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaveRoomReq.Merge(m, src)
+}
+func (m *LeaveRoomReq) XXX_Size() int {
+	return xxx_messageInfo_LeaveRoomReq.Size(m)
+}
+func (m *LeaveRoomReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaveRoomReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaveRoomReq proto.InternalMessageInfo
+
+// Game start when all members ready, at least 2 members
+type ReadyRoomReq struct {
+	Ready                bool     `protobuf:"varint,1,opt,name=ready,proto3" json:"ready,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadyRoomReq) Reset()         { *m = ReadyRoomReq{} }
+func (m *ReadyRoomReq) String() string { return proto.CompactTextString(m) }
+func (*ReadyRoomReq) ProtoMessage()    {}
+func (*ReadyRoomReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{19}
+}
+
+func (m *ReadyRoomReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadyRoomReq.Unmarshal(m, b)
+}
+func (m *ReadyRoomReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadyRoomReq.Marshal(b, m, deterministic)
+}
+func (m *ReadyRoomReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadyRoomReq.Merge(m, src)
+}
+func (m *ReadyRoomReq) XXX_Size() int {
+	return xxx_messageInfo_ReadyRoomReq.Size(m)
+}
+func (m *ReadyRoomReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadyRoomReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadyRoomReq proto.InternalMessageInfo
+
+func (m *ReadyRoomReq) GetReady() bool {
+	if m != nil {
+		return m.Ready
+	}
+	return false
+}
+
+// Replace all editable fields, send whole profile
+type UpdateProfileReq struct {
+	Nickname             string   `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Avatar               int32    `protobuf:"varint,2,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Bio                  string   `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateProfileReq) Reset()         { *m = UpdateProfileReq{} }
+func (m *UpdateProfileReq) String() string { return proto.CompactTextString(m) }
+func (*UpdateProfileReq) ProtoMessage()    {}
+func (*UpdateProfileReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{20}
+}
+
+func (m *UpdateProfileReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateProfileReq.Unmarshal(m, b)
+}
+func (m *UpdateProfileReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateProfileReq.Marshal(b, m, deterministic)
+}
+func (m *UpdateProfileReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateProfileReq.Merge(m, src)
+}
+func (m *UpdateProfileReq) XXX_Size() int {
+	return xxx_messageInfo_UpdateProfileReq.Size(m)
+}
+func (m *UpdateProfileReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateProfileReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateProfileReq proto.InternalMessageInfo
+
+func (m *UpdateProfileReq) GetNickname() string {
+	if m != nil {
+		return m.Nickname
+	}
+	return ""
+}
+
+func (m *UpdateProfileReq) GetAvatar() int32 {
+	if m != nil {
+		return m.Avatar
+	}
+	return 0
+}
+
+func (m *UpdateProfileReq) GetBio() string {
+	if m != nil {
+		return m.Bio
+	}
+	return ""
+}
+
+type Rsp struct {
+	Mid string `protobuf:"bytes,1,opt,name=mid,proto3" json:"mid,omitempty"`
+	// Types that are valid to be assigned to Rsp:
+	//	*Rsp_Error
+	//	*Rsp_GetUserInfoRsp
+	//	*Rsp_JoinChannelRsp
+	//	*Rsp_LeaveChannelRsp
+	//	*Rsp_GetChatHistoryRsp
+	//	*Rsp_SendFriendRsp
+	//	*Rsp_AcceptFriendRsp
+	//	*Rsp_DeclineFriendRsp
+	//	*Rsp_RemoveFriendRsp
+	//	*Rsp_GetFriendListRsp
+	//	*Rsp_GetProfileRsp
+	//	*Rsp_UpdateProfileRsp
+	//	*Rsp_SubmitScoreRsp
+	//	*Rsp_GetLeaderboardRsp
+	//	*Rsp_JoinMatchRsp
+	//	*Rsp_CancelMatchRsp
+	//	*Rsp_CreateRoomRsp
+	//	*Rsp_JoinRoomRsp
+	//	*Rsp_LeaveRoomRsp
+	//	*Rsp_ReadyRoomRsp
+	Rsp                  isRsp_Rsp `protobuf_oneof:"rsp"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *Rsp) Reset()         { *m = Rsp{} }
+func (m *Rsp) String() string { return proto.CompactTextString(m) }
+func (*Rsp) ProtoMessage()    {}
+func (*Rsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{21}
+}
+
+func (m *Rsp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Rsp.Unmarshal(m, b)
+}
+func (m *Rsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Rsp.Marshal(b, m, deterministic)
+}
+func (m *Rsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Rsp.Merge(m, src)
+}
+func (m *Rsp) XXX_Size() int {
+	return xxx_messageInfo_Rsp.Size(m)
+}
+func (m *Rsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_Rsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Rsp proto.InternalMessageInfo
+
+func (m *Rsp) GetMid() string {
+	if m != nil {
+		return m.Mid
+	}
+	return ""
+}
+
+type isRsp_Rsp interface {
+	isRsp_Rsp()
+}
+
+type Rsp_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+type Rsp_GetUserInfoRsp struct {
+	GetUserInfoRsp *GetUserInfoRsp `protobuf:"bytes,3,opt,name=getUserInfoRsp,proto3,oneof"`
+}
+
+type Rsp_JoinChannelRsp struct {
+	JoinChannelRsp *JoinChannelRsp `protobuf:"bytes,4,opt,name=joinChannelRsp,proto3,oneof"`
+}
+
+type Rsp_LeaveChannelRsp struct {
+	LeaveChannelRsp *LeaveChannelRsp `protobuf:"bytes,5,opt,name=leaveChannelRsp,proto3,oneof"`
+}
+
+type Rsp_GetChatHistoryRsp struct {
+	GetChatHistoryRsp *GetChatHistoryRsp `protobuf:"bytes,6,opt,name=getChatHistoryRsp,proto3,oneof"`
+}
+
+type Rsp_SendFriendRsp struct {
+	SendFriendRsp *SendFriendRsp `protobuf:"bytes,7,opt,name=sendFriendRsp,proto3,oneof"`
+}
+
+type Rsp_AcceptFriendRsp struct {
+	AcceptFriendRsp *AcceptFriendRsp `protobuf:"bytes,8,opt,name=acceptFriendRsp,proto3,oneof"`
+}
+
+type Rsp_DeclineFriendRsp struct {
+	DeclineFriendRsp *DeclineFriendRsp `protobuf:"bytes,9,opt,name=declineFriendRsp,proto3,oneof"`
+}
+
+type Rsp_RemoveFriendRsp struct {
+	RemoveFriendRsp *RemoveFriendRsp `protobuf:"bytes,10,opt,name=removeFriendRsp,proto3,oneof"`
+}
+
+type Rsp_GetFriendListRsp struct {
+	GetFriendListRsp *GetFriendListRsp `protobuf:"bytes,11,opt,name=getFriendListRsp,proto3,oneof"`
+}
+
+type Rsp_GetProfileRsp struct {
+	GetProfileRsp *GetProfileRsp `protobuf:"bytes,12,opt,name=getProfileRsp,proto3,oneof"`
+}
+
+type Rsp_UpdateProfileRsp struct {
+	UpdateProfileRsp *UpdateProfileRsp `protobuf:"bytes,13,opt,name=updateProfileRsp,proto3,oneof"`
+}
+
+type Rsp_SubmitScoreRsp struct {
+	SubmitScoreRsp *SubmitScoreRsp `protobuf:"bytes,14,opt,name=submitScoreRsp,proto3,oneof"`
+}
+
+type Rsp_GetLeaderboardRsp struct {
+	GetLeaderboardRsp *GetLeaderboardRsp `protobuf:"bytes,15,opt,name=getLeaderboardRsp,proto3,oneof"`
+}
+
+type Rsp_JoinMatchRsp struct {
+	JoinMatchRsp *JoinMatchRsp `protobuf:"bytes,16,opt,name=joinMatchRsp,proto3,oneof"`
+}
+
+type Rsp_CancelMatchRsp struct {
+	CancelMatchRsp *CancelMatchRsp `protobuf:"bytes,17,opt,name=cancelMatchRsp,proto3,oneof"`
+}
+
+type Rsp_CreateRoomRsp struct {
+	CreateRoomRsp *CreateRoomRsp `protobuf:"bytes,18,opt,name=createRoomRsp,proto3,oneof"`
+}
+
+type Rsp_JoinRoomRsp struct {
+	JoinRoomRsp *JoinRoomRsp `protobuf:"bytes,19,opt,name=joinRoomRsp,proto3,oneof"`
+}
+
+type Rsp_LeaveRoomRsp struct {
+	LeaveRoomRsp *LeaveRoomRsp `protobuf:"bytes,20,opt,name=leaveRoomRsp,proto3,oneof"`
+}
+
+type Rsp_ReadyRoomRsp struct {
+	ReadyRoomRsp *ReadyRoomRsp `protobuf:"bytes,21,opt,name=readyRoomRsp,proto3,oneof"`
+}
+
+func (*Rsp_Error) isRsp_Rsp() {}
+
+func (*Rsp_GetUserInfoRsp) isRsp_Rsp() {}
+
+func (*Rsp_JoinChannelRsp) isRsp_Rsp() {}
+
+func (*Rsp_LeaveChannelRsp) isRsp_Rsp() {}
+
+func (*Rsp_GetChatHistoryRsp) isRsp_Rsp() {}
+
+func (*Rsp_SendFriendRsp) isRsp_Rsp() {}
+
+func (*Rsp_AcceptFriendRsp) isRsp_Rsp() {}
+
+func (*Rsp_DeclineFriendRsp) isRsp_Rsp() {}
+
+func (*Rsp_RemoveFriendRsp) isRsp_Rsp() {}
+
+func (*Rsp_GetFriendListRsp) isRsp_Rsp() {}
+
+func (*Rsp_GetProfileRsp) isRsp_Rsp() {}
+
+func (*Rsp_UpdateProfileRsp) isRsp_Rsp() {}
+
+func (*Rsp_SubmitScoreRsp) isRsp_Rsp() {}
+
+func (*Rsp_GetLeaderboardRsp) isRsp_Rsp() {}
+
+func (*Rsp_JoinMatchRsp) isRsp_Rsp() {}
+
+func (*Rsp_CancelMatchRsp) isRsp_Rsp() {}
+
+func (*Rsp_CreateRoomRsp) isRsp_Rsp() {}
+
+func (*Rsp_JoinRoomRsp) isRsp_Rsp() {}
+
+func (*Rsp_LeaveRoomRsp) isRsp_Rsp() {}
+
+func (*Rsp_ReadyRoomRsp) isRsp_Rsp() {}
+
+func (m *Rsp) GetRsp() isRsp_Rsp {
+	if m != nil {
+		return m.Rsp
+	}
+	return nil
+}
+
+func (m *Rsp) GetError() *Error {
+	if x, ok := m.GetRsp().(*Rsp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (m *Rsp) GetGetUserInfoRsp() *GetUserInfoRsp {
+	if x, ok := m.GetRsp().(*Rsp_GetUserInfoRsp); ok {
+		return x.GetUserInfoRsp
+	}
+	return nil
+}
+
+func (m *Rsp) GetJoinChannelRsp() *JoinChannelRsp {
+	if x, ok := m.GetRsp().(*Rsp_JoinChannelRsp); ok {
+		return x.JoinChannelRsp
+	}
+	return nil
+}
+
+func (m *Rsp) GetLeaveChannelRsp() *LeaveChannelRsp {
+	if x, ok := m.GetRsp().(*Rsp_LeaveChannelRsp); ok {
+		return x.LeaveChannelRsp
+	}
+	return nil
+}
+
+func (m *Rsp) GetGetChatHistoryRsp() *GetChatHistoryRsp {
+	if x, ok := m.GetRsp().(*Rsp_GetChatHistoryRsp); ok {
+		return x.GetChatHistoryRsp
+	}
+	return nil
+}
+
+func (m *Rsp) GetSendFriendRsp() *SendFriendRsp {
+	if x, ok := m.GetRsp().(*Rsp_SendFriendRsp); ok {
+		return x.SendFriendRsp
+	}
+	return nil
+}
+
+func (m *Rsp) GetAcceptFriendRsp() *AcceptFriendRsp {
+	if x, ok := m.GetRsp().(*Rsp_AcceptFriendRsp); ok {
+		return x.AcceptFriendRsp
+	}
+	return nil
+}
+
+func (m *Rsp) GetDeclineFriendRsp() *DeclineFriendRsp {
+	if x, ok := m.GetRsp().(*Rsp_DeclineFriendRsp); ok {
+		return x.DeclineFriendRsp
+	}
+	return nil
+}
+
+func (m *Rsp) GetRemoveFriendRsp() *RemoveFriendRsp {
+	if x, ok := m.GetRsp().(*Rsp_RemoveFriendRsp); ok {
+		return x.RemoveFriendRsp
+	}
+	return nil
+}
+
+func (m *Rsp) GetGetFriendListRsp() *GetFriendListRsp {
+	if x, ok := m.GetRsp().(*Rsp_GetFriendListRsp); ok {
+		return x.GetFriendListRsp
+	}
+	return nil
+}
+
+func (m *Rsp) GetGetProfileRsp() *GetProfileRsp {
+	if x, ok := m.GetRsp().(*Rsp_GetProfileRsp); ok {
+		return x.GetProfileRsp
+	}
+	return nil
+}
+
+func (m *Rsp) GetUpdateProfileRsp() *UpdateProfileRsp {
+	if x, ok := m.GetRsp().(*Rsp_UpdateProfileRsp); ok {
+		return x.UpdateProfileRsp
+	}
+	return nil
+}
+
+func (m *Rsp) GetSubmitScoreRsp() *SubmitScoreRsp {
+	if x, ok := m.GetRsp().(*Rsp_SubmitScoreRsp); ok {
+		return x.SubmitScoreRsp
+	}
+	return nil
+}
+
+func (m *Rsp) GetGetLeaderboardRsp() *GetLeaderboardRsp {
+	if x, ok := m.GetRsp().(*Rsp_GetLeaderboardRsp); ok {
+		return x.GetLeaderboardRsp
+	}
+	return nil
+}
+
+func (m *Rsp) GetJoinMatchRsp() *JoinMatchRsp {
+	if x, ok := m.GetRsp().(*Rsp_JoinMatchRsp); ok {
+		return x.JoinMatchRsp
+	}
+	return nil
+}
+
+func (m *Rsp) GetCancelMatchRsp() *CancelMatchRsp {
+	if x, ok := m.GetRsp().(*Rsp_CancelMatchRsp); ok {
+		return x.CancelMatchRsp
+	}
+	return nil
+}
+
+func (m *Rsp) GetCreateRoomRsp() *CreateRoomRsp {
+	if x, ok := m.GetRsp().(*Rsp_CreateRoomRsp); ok {
+		return x.CreateRoomRsp
+	}
+	return nil
+}
+
+func (m *Rsp) GetJoinRoomRsp() *JoinRoomRsp {
+	if x, ok := m.GetRsp().(*Rsp_JoinRoomRsp); ok {
+		return x.JoinRoomRsp
+	}
+	return nil
+}
+
+func (m *Rsp) GetLeaveRoomRsp() *LeaveRoomRsp {
+	if x, ok := m.GetRsp().(*Rsp_LeaveRoomRsp); ok {
+		return x.LeaveRoomRsp
+	}
+	return nil
+}
+
+func (m *Rsp) GetReadyRoomRsp() *ReadyRoomRsp {
+	if x, ok := m.GetRsp().(*Rsp_ReadyRoomRsp); ok {
+		return x.ReadyRoomRsp
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Rsp) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Rsp_Error)(nil),
+		(*Rsp_GetUserInfoRsp)(nil),
+		(*Rsp_JoinChannelRsp)(nil),
+		(*Rsp_LeaveChannelRsp)(nil),
+		(*Rsp_GetChatHistoryRsp)(nil),
+		(*Rsp_SendFriendRsp)(nil),
+		(*Rsp_AcceptFriendRsp)(nil),
+		(*Rsp_DeclineFriendRsp)(nil),
+		(*Rsp_RemoveFriendRsp)(nil),
+		(*Rsp_GetFriendListRsp)(nil),
+		(*Rsp_GetProfileRsp)(nil),
+		(*Rsp_UpdateProfileRsp)(nil),
+		(*Rsp_SubmitScoreRsp)(nil),
+		(*Rsp_GetLeaderboardRsp)(nil),
+		(*Rsp_JoinMatchRsp)(nil),
+		(*Rsp_CancelMatchRsp)(nil),
+		(*Rsp_CreateRoomRsp)(nil),
+		(*Rsp_JoinRoomRsp)(nil),
+		(*Rsp_LeaveRoomRsp)(nil),
+		(*Rsp_ReadyRoomRsp)(nil),
+	}
+}
+
+type Error struct {
+	Message              string    `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Code                 ErrorCode `protobuf:"varint,2,opt,name=code,proto3,enum=pb.ErrorCode" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *Error) Reset()         { *m = Error{} }
+func (m *Error) String() string { return proto.CompactTextString(m) }
+func (*Error) ProtoMessage()    {}
+func (*Error) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{22}
+}
+
+func (m *Error) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Error.Unmarshal(m, b)
+}
+func (m *Error) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Error.Marshal(b, m, deterministic)
+}
+func (m *Error) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Error.Merge(m, src)
+}
+func (m *Error) XXX_Size() int {
+	return xxx_messageInfo_Error.Size(m)
+}
+func (m *Error) XXX_DiscardUnknown() {
+	xxx_messageInfo_Error.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Error proto.InternalMessageInfo
+
+func (m *Error) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *Error) GetCode() ErrorCode {
+	if m != nil {
+		return m.Code
+	}
+	return ErrorCode_ERR_UNKNOWN
+}
+
+type GetUserInfoRsp struct {
+	User                 *User    `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetUserInfoRsp) Reset()         { *m = GetUserInfoRsp{} }
+func (m *GetUserInfoRsp) String() string { return proto.CompactTextString(m) }
+func (*GetUserInfoRsp) ProtoMessage()    {}
+func (*GetUserInfoRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{23}
+}
+
+func (m *GetUserInfoRsp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetUserInfoRsp.Unmarshal(m, b)
+}
+func (m *GetUserInfoRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetUserInfoRsp.Marshal(b, m, deterministic)
+}
+func (m *GetUserInfoRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUserInfoRsp.Merge(m, src)
 }
 func (m *GetUserInfoRsp) XXX_Size() int {
 	return xxx_messageInfo_GetUserInfoRsp.Size(m)
 }
-func (m *GetUserInfoRsp) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetUserInfoRsp.DiscardUnknown(m)
+func (m *GetUserInfoRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUserInfoRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUserInfoRsp proto.InternalMessageInfo
+
+func (m *GetUserInfoRsp) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+type JoinChannelRsp struct {
+	Channel              string   `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JoinChannelRsp) Reset()         { *m = JoinChannelRsp{} }
+func (m *JoinChannelRsp) String() string { return proto.CompactTextString(m) }
+func (*JoinChannelRsp) ProtoMessage()    {}
+func (*JoinChannelRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{24}
+}
+
+func (m *JoinChannelRsp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JoinChannelRsp.Unmarshal(m, b)
+}
+func (m *JoinChannelRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JoinChannelRsp.Marshal(b, m, deterministic)
+}
+func (m *JoinChannelRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JoinChannelRsp.Merge(m, src)
+}
+func (m *JoinChannelRsp) XXX_Size() int {
+	return xxx_messageInfo_JoinChannelRsp.Size(m)
+}
+func (m *JoinChannelRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_JoinChannelRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JoinChannelRsp proto.InternalMessageInfo
+
+func (m *JoinChannelRsp) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+type LeaveChannelRsp struct {
+	Channel              string   `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LeaveChannelRsp) Reset()         { *m = LeaveChannelRsp{} }
+func (m *LeaveChannelRsp) String() string { return proto.CompactTextString(m) }
+func (*LeaveChannelRsp) ProtoMessage()    {}
+func (*LeaveChannelRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{25}
+}
+
+func (m *LeaveChannelRsp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeaveChannelRsp.Unmarshal(m, b)
+}
+func (m *LeaveChannelRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeaveChannelRsp.Marshal(b, m, deterministic)
+}
+func (m *LeaveChannelRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaveChannelRsp.Merge(m, src)
+}
+func (m *LeaveChannelRsp) XXX_Size() int {
+	return xxx_messageInfo_LeaveChannelRsp.Size(m)
+}
+func (m *LeaveChannelRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaveChannelRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaveChannelRsp proto.InternalMessageInfo
+
+func (m *LeaveChannelRsp) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+type GetChatHistoryRsp struct {
+	Channel              string      `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Messages             []*ChatPush `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	Before               string      `protobuf:"bytes,3,opt,name=before,proto3" json:"before,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *GetChatHistoryRsp) Reset()         { *m = GetChatHistoryRsp{} }
+func (m *GetChatHistoryRsp) String() string { return proto.CompactTextString(m) }
+func (*GetChatHistoryRsp) ProtoMessage()    {}
+func (*GetChatHistoryRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{26}
+}
+
+func (m *GetChatHistoryRsp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetChatHistoryRsp.Unmarshal(m, b)
+}
+func (m *GetChatHistoryRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetChatHistoryRsp.Marshal(b, m, deterministic)
+}
+func (m *GetChatHistoryRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetChatHistoryRsp.Merge(m, src)
+}
+func (m *GetChatHistoryRsp) XXX_Size() int {
+	return xxx_messageInfo_GetChatHistoryRsp.Size(m)
+}
+func (m *GetChatHistoryRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetChatHistoryRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetChatHistoryRsp proto.InternalMessageInfo
+
+func (m *GetChatHistoryRsp) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *GetChatHistoryRsp) GetMessages() []*ChatPush {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+func (m *GetChatHistoryRsp) GetBefore() string {
+	if m != nil {
+		return m.Before
+	}
+	return ""
+}
+
+type SendFriendRsp struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SendFriendRsp) Reset()         { *m = SendFriendRsp{} }
+func (m *SendFriendRsp) String() string { return proto.CompactTextString(m) }
+func (*SendFriendRsp) ProtoMessage()    {}
+func (*SendFriendRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{27}
+}
+
+func (m *SendFriendRsp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendFriendRsp.Unmarshal(m, b)
+}
+func (m *SendFriendRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendFriendRsp.Marshal(b, m, deterministic)
+}
+func (m *SendFriendRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendFriendRsp.Merge(m, src)
+}
+func (m *SendFriendRsp) XXX_Size() int {
+	return xxx_messageInfo_SendFriendRsp.Size(m)
+}
+func (m *SendFriendRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendFriendRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendFriendRsp proto.InternalMessageInfo
+
+func (m *SendFriendRsp) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type AcceptFriendRsp struct {
+	Friend               *Friend  `protobuf:"bytes,1,opt,name=friend,proto3" json:"friend,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AcceptFriendRsp) Reset()         { *m = AcceptFriendRsp{} }
+func (m *AcceptFriendRsp) String() string { return proto.CompactTextString(m) }
+func (*AcceptFriendRsp) ProtoMessage()    {}
+func (*AcceptFriendRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{28}
+}
+
+func (m *AcceptFriendRsp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AcceptFriendRsp.Unmarshal(m, b)
+}
+func (m *AcceptFriendRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AcceptFriendRsp.Marshal(b, m, deterministic)
+}
+func (m *AcceptFriendRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptFriendRsp.Merge(m, src)
+}
+func (m *AcceptFriendRsp) XXX_Size() int {
+	return xxx_messageInfo_AcceptFriendRsp.Size(m)
+}
+func (m *AcceptFriendRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptFriendRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptFriendRsp proto.InternalMessageInfo
+
+func (m *AcceptFriendRsp) GetFriend() *Friend {
+	if m != nil {
+		return m.Friend
+	}
+	return nil
+}
+
+type DeclineFriendRsp struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeclineFriendRsp) Reset()         { *m = DeclineFriendRsp{} }
+func (m *DeclineFriendRsp) String() string { return proto.CompactTextString(m) }
+func (*DeclineFriendRsp) ProtoMessage()    {}
+func (*DeclineFriendRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{29}
+}
+
+func (m *DeclineFriendRsp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeclineFriendRsp.Unmarshal(m, b)
+}
+func (m *DeclineFriendRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeclineFriendRsp.Marshal(b, m, deterministic)
+}
+func (m *DeclineFriendRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeclineFriendRsp.Merge(m, src)
+}
+func (m *DeclineFriendRsp) XXX_Size() int {
+	return xxx_messageInfo_DeclineFriendRsp.Size(m)
+}
+func (m *DeclineFriendRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeclineFriendRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeclineFriendRsp proto.InternalMessageInfo
+
+func (m *DeclineFriendRsp) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type RemoveFriendRsp struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveFriendRsp) Reset()         { *m = RemoveFriendRsp{} }
+func (m *RemoveFriendRsp) String() string { return proto.CompactTextString(m) }
+func (*RemoveFriendRsp) ProtoMessage()    {}
+func (*RemoveFriendRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{30}
+}
+
+func (m *RemoveFriendRsp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveFriendRsp.Unmarshal(m, b)
+}
+func (m *RemoveFriendRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveFriendRsp.Marshal(b, m, deterministic)
+}
+func (m *RemoveFriendRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveFriendRsp.Merge(m, src)
+}
+func (m *RemoveFriendRsp) XXX_Size() int {
+	return xxx_messageInfo_RemoveFriendRsp.Size(m)
+}
+func (m *RemoveFriendRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveFriendRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveFriendRsp proto.InternalMessageInfo
+
+func (m *RemoveFriendRsp) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type GetFriendListRsp struct {
+	Friends              []*Friend `protobuf:"bytes,1,rep,name=friends,proto3" json:"friends,omitempty"`
+	Requests             []*Friend `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *GetFriendListRsp) Reset()         { *m = GetFriendListRsp{} }
+func (m *GetFriendListRsp) String() string { return proto.CompactTextString(m) }
+func (*GetFriendListRsp) ProtoMessage()    {}
+func (*GetFriendListRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{31}
+}
+
+func (m *GetFriendListRsp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFriendListRsp.Unmarshal(m, b)
+}
+func (m *GetFriendListRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFriendListRsp.Marshal(b, m, deterministic)
+}
+func (m *GetFriendListRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFriendListRsp.Merge(m, src)
+}
+func (m *GetFriendListRsp) XXX_Size() int {
+	return xxx_messageInfo_GetFriendListRsp.Size(m)
+}
+func (m *GetFriendListRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFriendListRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFriendListRsp proto.InternalMessageInfo
+
+func (m *GetFriendListRsp) GetFriends() []*Friend {
+	if m != nil {
+		return m.Friends
+	}
+	return nil
+}
+
+func (m *GetFriendListRsp) GetRequests() []*Friend {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+type GetProfileRsp struct {
+	Profile              *Profile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetProfileRsp) Reset()         { *m = GetProfileRsp{} }
+func (m *GetProfileRsp) String() string { return proto.CompactTextString(m) }
+func (*GetProfileRsp) ProtoMessage()    {}
+func (*GetProfileRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{32}
+}
+
+func (m *GetProfileRsp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProfileRsp.Unmarshal(m, b)
+}
+func (m *GetProfileRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProfileRsp.Marshal(b, m, deterministic)
+}
+func (m *GetProfileRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProfileRsp.Merge(m, src)
+}
+func (m *GetProfileRsp) XXX_Size() int {
+	return xxx_messageInfo_GetProfileRsp.Size(m)
+}
+func (m *GetProfileRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProfileRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProfileRsp proto.InternalMessageInfo
+
+func (m *GetProfileRsp) GetProfile() *Profile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+type UpdateProfileRsp struct {
+	Profile              *Profile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateProfileRsp) Reset()         { *m = UpdateProfileRsp{} }
+func (m *UpdateProfileRsp) String() string { return proto.CompactTextString(m) }
+func (*UpdateProfileRsp) ProtoMessage()    {}
+func (*UpdateProfileRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{33}
+}
+
+func (m *UpdateProfileRsp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateProfileRsp.Unmarshal(m, b)
+}
+func (m *UpdateProfileRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateProfileRsp.Marshal(b, m, deterministic)
+}
+func (m *UpdateProfileRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateProfileRsp.Merge(m, src)
+}
+func (m *UpdateProfileRsp) XXX_Size() int {
+	return xxx_messageInfo_UpdateProfileRsp.Size(m)
+}
+func (m *UpdateProfileRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateProfileRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateProfileRsp proto.InternalMessageInfo
+
+func (m *UpdateProfileRsp) GetProfile() *Profile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+// Public user info, never contains email
+type Profile struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Nickname             string   `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Avatar               int32    `protobuf:"varint,4,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Bio                  string   `protobuf:"bytes,5,opt,name=bio,proto3" json:"bio,omitempty"`
+	Level                int32    `protobuf:"varint,6,opt,name=level,proto3" json:"level,omitempty"`
+	Rating               int32    `protobuf:"varint,7,opt,name=rating,proto3" json:"rating,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Profile) Reset()         { *m = Profile{} }
+func (m *Profile) String() string { return proto.CompactTextString(m) }
+func (*Profile) ProtoMessage()    {}
+func (*Profile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{34}
+}
+
+func (m *Profile) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Profile.Unmarshal(m, b)
+}
+func (m *Profile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Profile.Marshal(b, m, deterministic)
+}
+func (m *Profile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Profile.Merge(m, src)
+}
+func (m *Profile) XXX_Size() int {
+	return xxx_messageInfo_Profile.Size(m)
+}
+func (m *Profile) XXX_DiscardUnknown() {
+	xxx_messageInfo_Profile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Profile proto.InternalMessageInfo
+
+func (m *Profile) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *Profile) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Profile) GetNickname() string {
+	if m != nil {
+		return m.Nickname
+	}
+	return ""
+}
+
+func (m *Profile) GetAvatar() int32 {
+	if m != nil {
+		return m.Avatar
+	}
+	return 0
+}
+
+func (m *Profile) GetBio() string {
+	if m != nil {
+		return m.Bio
+	}
+	return ""
+}
+
+func (m *Profile) GetLevel() int32 {
+	if m != nil {
+		return m.Level
+	}
+	return 0
+}
+
+func (m *Profile) GetRating() int32 {
+	if m != nil {
+		return m.Rating
+	}
+	return 0
+}
+
+type SubmitScoreRsp struct {
+	Entry                *LeaderboardEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SubmitScoreRsp) Reset()         { *m = SubmitScoreRsp{} }
+func (m *SubmitScoreRsp) String() string { return proto.CompactTextString(m) }
+func (*SubmitScoreRsp) ProtoMessage()    {}
+func (*SubmitScoreRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{35}
+}
+
+func (m *SubmitScoreRsp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubmitScoreRsp.Unmarshal(m, b)
+}
+func (m *SubmitScoreRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubmitScoreRsp.Marshal(b, m, deterministic)
+}
+func (m *SubmitScoreRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitScoreRsp.Merge(m, src)
+}
+func (m *SubmitScoreRsp) XXX_Size() int {
+	return xxx_messageInfo_SubmitScoreRsp.Size(m)
+}
+func (m *SubmitScoreRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitScoreRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmitScoreRsp proto.InternalMessageInfo
+
+func (m *SubmitScoreRsp) GetEntry() *LeaderboardEntry {
+	if m != nil {
+		return m.Entry
+	}
+	return nil
+}
+
+type GetLeaderboardRsp struct {
+	Leaderboard          *Leaderboard `protobuf:"bytes,1,opt,name=leaderboard,proto3" json:"leaderboard,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *GetLeaderboardRsp) Reset()         { *m = GetLeaderboardRsp{} }
+func (m *GetLeaderboardRsp) String() string { return proto.CompactTextString(m) }
+func (*GetLeaderboardRsp) ProtoMessage()    {}
+func (*GetLeaderboardRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{36}
+}
+
+func (m *GetLeaderboardRsp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLeaderboardRsp.Unmarshal(m, b)
+}
+func (m *GetLeaderboardRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLeaderboardRsp.Marshal(b, m, deterministic)
+}
+func (m *GetLeaderboardRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLeaderboardRsp.Merge(m, src)
+}
+func (m *GetLeaderboardRsp) XXX_Size() int {
+	return xxx_messageInfo_GetLeaderboardRsp.Size(m)
+}
+func (m *GetLeaderboardRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLeaderboardRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLeaderboardRsp proto.InternalMessageInfo
+
+func (m *GetLeaderboardRsp) GetLeaderboard() *Leaderboard {
+	if m != nil {
+		return m.Leaderboard
+	}
+	return nil
+}
+
+type JoinMatchRsp struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JoinMatchRsp) Reset()         { *m = JoinMatchRsp{} }
+func (m *JoinMatchRsp) String() string { return proto.CompactTextString(m) }
+func (*JoinMatchRsp) ProtoMessage()    {}
+func (*JoinMatchRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{37}
+}
+
+func (m *JoinMatchRsp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JoinMatchRsp.Unmarshal(m, b)
+}
+func (m *JoinMatchRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JoinMatchRsp.Marshal(b, m, deterministic)
+}
+func (m *JoinMatchRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JoinMatchRsp.Merge(m, src)
+}
+func (m *JoinMatchRsp) XXX_Size() int {
+	return xxx_messageInfo_JoinMatchRsp.Size(m)
+}
+func (m *JoinMatchRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_JoinMatchRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JoinMatchRsp proto.InternalMessageInfo
+
+type CancelMatchRsp struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelMatchRsp) Reset()         { *m = CancelMatchRsp{} }
+func (m *CancelMatchRsp) String() string { return proto.CompactTextString(m) }
+func (*CancelMatchRsp) ProtoMessage()    {}
+func (*CancelMatchRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{38}
+}
+
+func (m *CancelMatchRsp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelMatchRsp.Unmarshal(m, b)
+}
+func (m *CancelMatchRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelMatchRsp.Marshal(b, m, deterministic)
+}
+func (m *CancelMatchRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelMatchRsp.Merge(m, src)
+}
+func (m *CancelMatchRsp) XXX_Size() int {
+	return xxx_messageInfo_CancelMatchRsp.Size(m)
+}
+func (m *CancelMatchRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelMatchRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelMatchRsp proto.InternalMessageInfo
+
+type CreateRoomRsp struct {
+	Room                 *RoomInfo `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *CreateRoomRsp) Reset()         { *m = CreateRoomRsp{} }
+func (m *CreateRoomRsp) String() string { return proto.CompactTextString(m) }
+func (*CreateRoomRsp) ProtoMessage()    {}
+func (*CreateRoomRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{39}
+}
+
+func (m *CreateRoomRsp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateRoomRsp.Unmarshal(m, b)
+}
+func (m *CreateRoomRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateRoomRsp.Marshal(b, m, deterministic)
+}
+func (m *CreateRoomRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRoomRsp.Merge(m, src)
+}
+func (m *CreateRoomRsp) XXX_Size() int {
+	return xxx_messageInfo_CreateRoomRsp.Size(m)
+}
+func (m *CreateRoomRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRoomRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateRoomRsp proto.InternalMessageInfo
+
+func (m *CreateRoomRsp) GetRoom() *RoomInfo {
+	if m != nil {
+		return m.Room
+	}
+	return nil
+}
+
+type JoinRoomRsp struct {
+	Room                 *RoomInfo `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *JoinRoomRsp) Reset()         { *m = JoinRoomRsp{} }
+func (m *JoinRoomRsp) String() string { return proto.CompactTextString(m) }
+func (*JoinRoomRsp) ProtoMessage()    {}
+func (*JoinRoomRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{40}
+}
+
+func (m *JoinRoomRsp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JoinRoomRsp.Unmarshal(m, b)
+}
+func (m *JoinRoomRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JoinRoomRsp.Marshal(b, m, deterministic)
+}
+func (m *JoinRoomRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JoinRoomRsp.Merge(m, src)
+}
+func (m *JoinRoomRsp) XXX_Size() int {
+	return xxx_messageInfo_JoinRoomRsp.Size(m)
+}
+func (m *JoinRoomRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_JoinRoomRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JoinRoomRsp proto.InternalMessageInfo
+
+func (m *JoinRoomRsp) GetRoom() *RoomInfo {
+	if m != nil {
+		return m.Room
+	}
+	return nil
+}
+
+type LeaveRoomRsp struct {
+	RoomId               string   `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LeaveRoomRsp) Reset()         { *m = LeaveRoomRsp{} }
+func (m *LeaveRoomRsp) String() string { return proto.CompactTextString(m) }
+func (*LeaveRoomRsp) ProtoMessage()    {}
+func (*LeaveRoomRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{41}
+}
+
+func (m *LeaveRoomRsp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeaveRoomRsp.Unmarshal(m, b)
+}
+func (m *LeaveRoomRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeaveRoomRsp.Marshal(b, m, deterministic)
+}
+func (m *LeaveRoomRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaveRoomRsp.Merge(m, src)
+}
+func (m *LeaveRoomRsp) XXX_Size() int {
+	return xxx_messageInfo_LeaveRoomRsp.Size(m)
+}
+func (m *LeaveRoomRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaveRoomRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaveRoomRsp proto.InternalMessageInfo
+
+func (m *LeaveRoomRsp) GetRoomId() string {
+	if m != nil {
+		return m.RoomId
+	}
+	return ""
+}
+
+type ReadyRoomRsp struct {
+	Ready                bool     `protobuf:"varint,1,opt,name=ready,proto3" json:"ready,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadyRoomRsp) Reset()         { *m = ReadyRoomRsp{} }
+func (m *ReadyRoomRsp) String() string { return proto.CompactTextString(m) }
+func (*ReadyRoomRsp) ProtoMessage()    {}
+func (*ReadyRoomRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{42}
+}
+
+func (m *ReadyRoomRsp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadyRoomRsp.Unmarshal(m, b)
+}
+func (m *ReadyRoomRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadyRoomRsp.Marshal(b, m, deterministic)
+}
+func (m *ReadyRoomRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadyRoomRsp.Merge(m, src)
+}
+func (m *ReadyRoomRsp) XXX_Size() int {
+	return xxx_messageInfo_ReadyRoomRsp.Size(m)
+}
+func (m *ReadyRoomRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadyRoomRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadyRoomRsp proto.InternalMessageInfo
+
+func (m *ReadyRoomRsp) GetReady() bool {
+	if m != nil {
+		return m.Ready
+	}
+	return false
+}
+
+type RoomInfo struct {
+	RoomId               string        `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	MaxPlayers           int32         `protobuf:"varint,2,opt,name=max_players,json=maxPlayers,proto3" json:"max_players,omitempty"`
+	Started              bool          `protobuf:"varint,3,opt,name=started,proto3" json:"started,omitempty"`
+	Members              []*RoomMember `protobuf:"bytes,4,rep,name=members,proto3" json:"members,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *RoomInfo) Reset()         { *m = RoomInfo{} }
+func (m *RoomInfo) String() string { return proto.CompactTextString(m) }
+func (*RoomInfo) ProtoMessage()    {}
+func (*RoomInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{43}
+}
+
+func (m *RoomInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoomInfo.Unmarshal(m, b)
+}
+func (m *RoomInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoomInfo.Marshal(b, m, deterministic)
+}
+func (m *RoomInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoomInfo.Merge(m, src)
+}
+func (m *RoomInfo) XXX_Size() int {
+	return xxx_messageInfo_RoomInfo.Size(m)
+}
+func (m *RoomInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoomInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoomInfo proto.InternalMessageInfo
+
+func (m *RoomInfo) GetRoomId() string {
+	if m != nil {
+		return m.RoomId
+	}
+	return ""
+}
+
+func (m *RoomInfo) GetMaxPlayers() int32 {
+	if m != nil {
+		return m.MaxPlayers
+	}
+	return 0
+}
+
+func (m *RoomInfo) GetStarted() bool {
+	if m != nil {
+		return m.Started
+	}
+	return false
+}
+
+func (m *RoomInfo) GetMembers() []*RoomMember {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+type RoomMember struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Ready                bool     `protobuf:"varint,3,opt,name=ready,proto3" json:"ready,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RoomMember) Reset()         { *m = RoomMember{} }
+func (m *RoomMember) String() string { return proto.CompactTextString(m) }
+func (*RoomMember) ProtoMessage()    {}
+func (*RoomMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{44}
+}
+
+func (m *RoomMember) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoomMember.Unmarshal(m, b)
+}
+func (m *RoomMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoomMember.Marshal(b, m, deterministic)
+}
+func (m *RoomMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoomMember.Merge(m, src)
+}
+func (m *RoomMember) XXX_Size() int {
+	return xxx_messageInfo_RoomMember.Size(m)
+}
+func (m *RoomMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoomMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoomMember proto.InternalMessageInfo
+
+func (m *RoomMember) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *RoomMember) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RoomMember) GetReady() bool {
+	if m != nil {
+		return m.Ready
+	}
+	return false
+}
+
+type Leaderboard struct {
+	Board                string              `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	Period               string              `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	Entries              []*LeaderboardEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *Leaderboard) Reset()         { *m = Leaderboard{} }
+func (m *Leaderboard) String() string { return proto.CompactTextString(m) }
+func (*Leaderboard) ProtoMessage()    {}
+func (*Leaderboard) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{45}
+}
+
+func (m *Leaderboard) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Leaderboard.Unmarshal(m, b)
+}
+func (m *Leaderboard) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Leaderboard.Marshal(b, m, deterministic)
+}
+func (m *Leaderboard) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Leaderboard.Merge(m, src)
+}
+func (m *Leaderboard) XXX_Size() int {
+	return xxx_messageInfo_Leaderboard.Size(m)
+}
+func (m *Leaderboard) XXX_DiscardUnknown() {
+	xxx_messageInfo_Leaderboard.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Leaderboard proto.InternalMessageInfo
+
+func (m *Leaderboard) GetBoard() string {
+	if m != nil {
+		return m.Board
+	}
+	return ""
+}
+
+func (m *Leaderboard) GetPeriod() string {
+	if m != nil {
+		return m.Period
+	}
+	return ""
+}
+
+func (m *Leaderboard) GetEntries() []*LeaderboardEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type LeaderboardEntry struct {
+	Rank                 int32    `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Uid                  string   `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Score                int64    `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LeaderboardEntry) Reset()         { *m = LeaderboardEntry{} }
+func (m *LeaderboardEntry) String() string { return proto.CompactTextString(m) }
+func (*LeaderboardEntry) ProtoMessage()    {}
+func (*LeaderboardEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{46}
+}
+
+func (m *LeaderboardEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeaderboardEntry.Unmarshal(m, b)
+}
+func (m *LeaderboardEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeaderboardEntry.Marshal(b, m, deterministic)
+}
+func (m *LeaderboardEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaderboardEntry.Merge(m, src)
+}
+func (m *LeaderboardEntry) XXX_Size() int {
+	return xxx_messageInfo_LeaderboardEntry.Size(m)
+}
+func (m *LeaderboardEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaderboardEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaderboardEntry proto.InternalMessageInfo
+
+func (m *LeaderboardEntry) GetRank() int32 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *LeaderboardEntry) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *LeaderboardEntry) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *LeaderboardEntry) GetScore() int64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+type Friend struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Online               bool     `protobuf:"varint,3,opt,name=online,proto3" json:"online,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Friend) Reset()         { *m = Friend{} }
+func (m *Friend) String() string { return proto.CompactTextString(m) }
+func (*Friend) ProtoMessage()    {}
+func (*Friend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{47}
+}
+
+func (m *Friend) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Friend.Unmarshal(m, b)
+}
+func (m *Friend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Friend.Marshal(b, m, deterministic)
+}
+func (m *Friend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Friend.Merge(m, src)
+}
+func (m *Friend) XXX_Size() int {
+	return xxx_messageInfo_Friend.Size(m)
+}
+func (m *Friend) XXX_DiscardUnknown() {
+	xxx_messageInfo_Friend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Friend proto.InternalMessageInfo
+
+func (m *Friend) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *Friend) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Friend) GetOnline() bool {
+	if m != nil {
+		return m.Online
+	}
+	return false
+}
+
+type Notify struct {
+	// Types that are valid to be assigned to Notify:
+	//	*Notify_ChatNotify
+	//	*Notify_DirectMessageNotify
+	//	*Notify_RoomInputNotify
+	//	*Notify_AckNotify
+	Notify               isNotify_Notify `protobuf_oneof:"notify"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Notify) Reset()         { *m = Notify{} }
+func (m *Notify) String() string { return proto.CompactTextString(m) }
+func (*Notify) ProtoMessage()    {}
+func (*Notify) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{48}
+}
+
+func (m *Notify) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Notify.Unmarshal(m, b)
+}
+func (m *Notify) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Notify.Marshal(b, m, deterministic)
+}
+func (m *Notify) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Notify.Merge(m, src)
+}
+func (m *Notify) XXX_Size() int {
+	return xxx_messageInfo_Notify.Size(m)
+}
+func (m *Notify) XXX_DiscardUnknown() {
+	xxx_messageInfo_Notify.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Notify proto.InternalMessageInfo
+
+type isNotify_Notify interface {
+	isNotify_Notify()
+}
+
+type Notify_ChatNotify struct {
+	ChatNotify *ChatNotify `protobuf:"bytes,1,opt,name=chatNotify,proto3,oneof"`
+}
+
+type Notify_DirectMessageNotify struct {
+	DirectMessageNotify *DirectMessageNotify `protobuf:"bytes,2,opt,name=directMessageNotify,proto3,oneof"`
+}
+
+type Notify_RoomInputNotify struct {
+	RoomInputNotify *RoomInputNotify `protobuf:"bytes,3,opt,name=roomInputNotify,proto3,oneof"`
+}
+
+type Notify_AckNotify struct {
+	AckNotify *AckNotify `protobuf:"bytes,4,opt,name=ackNotify,proto3,oneof"`
+}
+
+func (*Notify_ChatNotify) isNotify_Notify() {}
+
+func (*Notify_DirectMessageNotify) isNotify_Notify() {}
+
+func (*Notify_RoomInputNotify) isNotify_Notify() {}
+
+func (*Notify_AckNotify) isNotify_Notify() {}
+
+func (m *Notify) GetNotify() isNotify_Notify {
+	if m != nil {
+		return m.Notify
+	}
+	return nil
+}
+
+func (m *Notify) GetChatNotify() *ChatNotify {
+	if x, ok := m.GetNotify().(*Notify_ChatNotify); ok {
+		return x.ChatNotify
+	}
+	return nil
+}
+
+func (m *Notify) GetDirectMessageNotify() *DirectMessageNotify {
+	if x, ok := m.GetNotify().(*Notify_DirectMessageNotify); ok {
+		return x.DirectMessageNotify
+	}
+	return nil
+}
+
+func (m *Notify) GetRoomInputNotify() *RoomInputNotify {
+	if x, ok := m.GetNotify().(*Notify_RoomInputNotify); ok {
+		return x.RoomInputNotify
+	}
+	return nil
+}
+
+func (m *Notify) GetAckNotify() *AckNotify {
+	if x, ok := m.GetNotify().(*Notify_AckNotify); ok {
+		return x.AckNotify
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Notify) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Notify_ChatNotify)(nil),
+		(*Notify_DirectMessageNotify)(nil),
+		(*Notify_RoomInputNotify)(nil),
+		(*Notify_AckNotify)(nil),
+	}
+}
+
+// All pushes up to seq received, critical ones not acked are redelivered
+type AckNotify struct {
+	Seq                  uint64   `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AckNotify) Reset()         { *m = AckNotify{} }
+func (m *AckNotify) String() string { return proto.CompactTextString(m) }
+func (*AckNotify) ProtoMessage()    {}
+func (*AckNotify) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{49}
+}
+
+func (m *AckNotify) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AckNotify.Unmarshal(m, b)
+}
+func (m *AckNotify) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AckNotify.Marshal(b, m, deterministic)
+}
+func (m *AckNotify) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AckNotify.Merge(m, src)
+}
+func (m *AckNotify) XXX_Size() int {
+	return xxx_messageInfo_AckNotify.Size(m)
+}
+func (m *AckNotify) XXX_DiscardUnknown() {
+	xxx_messageInfo_AckNotify.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AckNotify proto.InternalMessageInfo
+
+func (m *AckNotify) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+type ChatNotify struct {
+	Message              string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Channel              string   `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChatNotify) Reset()         { *m = ChatNotify{} }
+func (m *ChatNotify) String() string { return proto.CompactTextString(m) }
+func (*ChatNotify) ProtoMessage()    {}
+func (*ChatNotify) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{50}
+}
+
+func (m *ChatNotify) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChatNotify.Unmarshal(m, b)
+}
+func (m *ChatNotify) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChatNotify.Marshal(b, m, deterministic)
+}
+func (m *ChatNotify) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChatNotify.Merge(m, src)
+}
+func (m *ChatNotify) XXX_Size() int {
+	return xxx_messageInfo_ChatNotify.Size(m)
+}
+func (m *ChatNotify) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChatNotify.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChatNotify proto.InternalMessageInfo
+
+func (m *ChatNotify) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ChatNotify) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+// Latest input is applied every tick until next input
+type RoomInputNotify struct {
+	MoveX                int32    `protobuf:"varint,1,opt,name=move_x,json=moveX,proto3" json:"move_x,omitempty"`
+	MoveY                int32    `protobuf:"varint,2,opt,name=move_y,json=moveY,proto3" json:"move_y,omitempty"`
+	Seq                  uint32   `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RoomInputNotify) Reset()         { *m = RoomInputNotify{} }
+func (m *RoomInputNotify) String() string { return proto.CompactTextString(m) }
+func (*RoomInputNotify) ProtoMessage()    {}
+func (*RoomInputNotify) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{51}
+}
+
+func (m *RoomInputNotify) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoomInputNotify.Unmarshal(m, b)
+}
+func (m *RoomInputNotify) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoomInputNotify.Marshal(b, m, deterministic)
+}
+func (m *RoomInputNotify) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoomInputNotify.Merge(m, src)
+}
+func (m *RoomInputNotify) XXX_Size() int {
+	return xxx_messageInfo_RoomInputNotify.Size(m)
+}
+func (m *RoomInputNotify) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoomInputNotify.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoomInputNotify proto.InternalMessageInfo
+
+func (m *RoomInputNotify) GetMoveX() int32 {
+	if m != nil {
+		return m.MoveX
+	}
+	return 0
+}
+
+func (m *RoomInputNotify) GetMoveY() int32 {
+	if m != nil {
+		return m.MoveY
+	}
+	return 0
+}
+
+func (m *RoomInputNotify) GetSeq() uint32 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+// Send to user, kept at inbox if user offline
+type DirectMessageNotify struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DirectMessageNotify) Reset()         { *m = DirectMessageNotify{} }
+func (m *DirectMessageNotify) String() string { return proto.CompactTextString(m) }
+func (*DirectMessageNotify) ProtoMessage()    {}
+func (*DirectMessageNotify) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{52}
+}
+
+func (m *DirectMessageNotify) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DirectMessageNotify.Unmarshal(m, b)
+}
+func (m *DirectMessageNotify) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DirectMessageNotify.Marshal(b, m, deterministic)
+}
+func (m *DirectMessageNotify) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DirectMessageNotify.Merge(m, src)
+}
+func (m *DirectMessageNotify) XXX_Size() int {
+	return xxx_messageInfo_DirectMessageNotify.Size(m)
+}
+func (m *DirectMessageNotify) XXX_DiscardUnknown() {
+	xxx_messageInfo_DirectMessageNotify.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DirectMessageNotify proto.InternalMessageInfo
+
+func (m *DirectMessageNotify) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *DirectMessageNotify) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type Push struct {
+	// Types that are valid to be assigned to Push:
+	//	*Push_ChatPush
+	//	*Push_SystemPush
+	//	*Push_FriendRequestPush
+	//	*Push_FriendAcceptPush
+	//	*Push_FriendPresencePush
+	//	*Push_DirectMessagePush
+	//	*Push_MatchFoundPush
+	//	*Push_RoomUpdatePush
+	//	*Push_RoomSnapshotPush
+	//	*Push_SessionPush
+	Push                 isPush_Push `protobuf_oneof:"push"`
+	Seq                  uint64      `protobuf:"varint,15,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *Push) Reset()         { *m = Push{} }
+func (m *Push) String() string { return proto.CompactTextString(m) }
+func (*Push) ProtoMessage()    {}
+func (*Push) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{53}
+}
+
+func (m *Push) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Push.Unmarshal(m, b)
+}
+func (m *Push) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Push.Marshal(b, m, deterministic)
+}
+func (m *Push) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Push.Merge(m, src)
+}
+func (m *Push) XXX_Size() int {
+	return xxx_messageInfo_Push.Size(m)
+}
+func (m *Push) XXX_DiscardUnknown() {
+	xxx_messageInfo_Push.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Push proto.InternalMessageInfo
+
+type isPush_Push interface {
+	isPush_Push()
+}
+
+type Push_ChatPush struct {
+	ChatPush *ChatPush `protobuf:"bytes,1,opt,name=chatPush,proto3,oneof"`
+}
+
+type Push_SystemPush struct {
+	SystemPush *SystemPush `protobuf:"bytes,2,opt,name=systemPush,proto3,oneof"`
+}
+
+type Push_FriendRequestPush struct {
+	FriendRequestPush *FriendRequestPush `protobuf:"bytes,3,opt,name=friendRequestPush,proto3,oneof"`
+}
+
+type Push_FriendAcceptPush struct {
+	FriendAcceptPush *FriendAcceptPush `protobuf:"bytes,4,opt,name=friendAcceptPush,proto3,oneof"`
+}
+
+type Push_FriendPresencePush struct {
+	FriendPresencePush *FriendPresencePush `protobuf:"bytes,5,opt,name=friendPresencePush,proto3,oneof"`
+}
+
+type Push_DirectMessagePush struct {
+	DirectMessagePush *DirectMessagePush `protobuf:"bytes,6,opt,name=directMessagePush,proto3,oneof"`
+}
+
+type Push_MatchFoundPush struct {
+	MatchFoundPush *MatchFoundPush `protobuf:"bytes,7,opt,name=matchFoundPush,proto3,oneof"`
+}
+
+type Push_RoomUpdatePush struct {
+	RoomUpdatePush *RoomUpdatePush `protobuf:"bytes,8,opt,name=roomUpdatePush,proto3,oneof"`
+}
+
+type Push_RoomSnapshotPush struct {
+	RoomSnapshotPush *RoomSnapshotPush `protobuf:"bytes,9,opt,name=roomSnapshotPush,proto3,oneof"`
+}
+
+type Push_SessionPush struct {
+	SessionPush *SessionPush `protobuf:"bytes,10,opt,name=sessionPush,proto3,oneof"`
+}
+
+func (*Push_ChatPush) isPush_Push() {}
+
+func (*Push_SystemPush) isPush_Push() {}
+
+func (*Push_FriendRequestPush) isPush_Push() {}
+
+func (*Push_FriendAcceptPush) isPush_Push() {}
+
+func (*Push_FriendPresencePush) isPush_Push() {}
+
+func (*Push_DirectMessagePush) isPush_Push() {}
+
+func (*Push_MatchFoundPush) isPush_Push() {}
+
+func (*Push_RoomUpdatePush) isPush_Push() {}
+
+func (*Push_RoomSnapshotPush) isPush_Push() {}
+
+func (*Push_SessionPush) isPush_Push() {}
+
+func (m *Push) GetPush() isPush_Push {
+	if m != nil {
+		return m.Push
+	}
+	return nil
+}
+
+func (m *Push) GetChatPush() *ChatPush {
+	if x, ok := m.GetPush().(*Push_ChatPush); ok {
+		return x.ChatPush
+	}
+	return nil
+}
+
+func (m *Push) GetSystemPush() *SystemPush {
+	if x, ok := m.GetPush().(*Push_SystemPush); ok {
+		return x.SystemPush
+	}
+	return nil
+}
+
+func (m *Push) GetFriendRequestPush() *FriendRequestPush {
+	if x, ok := m.GetPush().(*Push_FriendRequestPush); ok {
+		return x.FriendRequestPush
+	}
+	return nil
+}
+
+func (m *Push) GetFriendAcceptPush() *FriendAcceptPush {
+	if x, ok := m.GetPush().(*Push_FriendAcceptPush); ok {
+		return x.FriendAcceptPush
+	}
+	return nil
+}
+
+func (m *Push) GetFriendPresencePush() *FriendPresencePush {
+	if x, ok := m.GetPush().(*Push_FriendPresencePush); ok {
+		return x.FriendPresencePush
+	}
+	return nil
+}
+
+func (m *Push) GetDirectMessagePush() *DirectMessagePush {
+	if x, ok := m.GetPush().(*Push_DirectMessagePush); ok {
+		return x.DirectMessagePush
+	}
+	return nil
+}
+
+func (m *Push) GetMatchFoundPush() *MatchFoundPush {
+	if x, ok := m.GetPush().(*Push_MatchFoundPush); ok {
+		return x.MatchFoundPush
+	}
+	return nil
+}
+
+func (m *Push) GetRoomUpdatePush() *RoomUpdatePush {
+	if x, ok := m.GetPush().(*Push_RoomUpdatePush); ok {
+		return x.RoomUpdatePush
+	}
+	return nil
+}
+
+func (m *Push) GetRoomSnapshotPush() *RoomSnapshotPush {
+	if x, ok := m.GetPush().(*Push_RoomSnapshotPush); ok {
+		return x.RoomSnapshotPush
+	}
+	return nil
+}
+
+func (m *Push) GetSessionPush() *SessionPush {
+	if x, ok := m.GetPush().(*Push_SessionPush); ok {
+		return x.SessionPush
+	}
+	return nil
+}
+
+func (m *Push) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Push) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Push_ChatPush)(nil),
+		(*Push_SystemPush)(nil),
+		(*Push_FriendRequestPush)(nil),
+		(*Push_FriendAcceptPush)(nil),
+		(*Push_FriendPresencePush)(nil),
+		(*Push_DirectMessagePush)(nil),
+		(*Push_MatchFoundPush)(nil),
+		(*Push_RoomUpdatePush)(nil),
+		(*Push_RoomSnapshotPush)(nil),
+		(*Push_SessionPush)(nil),
+	}
+}
+
+type ChatPush struct {
+	Message              string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Channel              string   `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Id                   string   `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	Uid                  string   `protobuf:"bytes,4,opt,name=uid,proto3" json:"uid,omitempty"`
+	Name                 string   `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Time                 int64    `protobuf:"varint,6,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChatPush) Reset()         { *m = ChatPush{} }
+func (m *ChatPush) String() string { return proto.CompactTextString(m) }
+func (*ChatPush) ProtoMessage()    {}
+func (*ChatPush) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{54}
+}
+
+func (m *ChatPush) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChatPush.Unmarshal(m, b)
+}
+func (m *ChatPush) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChatPush.Marshal(b, m, deterministic)
+}
+func (m *ChatPush) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChatPush.Merge(m, src)
+}
+func (m *ChatPush) XXX_Size() int {
+	return xxx_messageInfo_ChatPush.Size(m)
+}
+func (m *ChatPush) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChatPush.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChatPush proto.InternalMessageInfo
+
+func (m *ChatPush) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ChatPush) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *ChatPush) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ChatPush) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *ChatPush) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ChatPush) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+// Received direct message, or echo of sent one
+type DirectMessagePush struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uid                  string   `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	To                   string   `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Message              string   `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Time                 int64    `protobuf:"varint,6,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DirectMessagePush) Reset()         { *m = DirectMessagePush{} }
+func (m *DirectMessagePush) String() string { return proto.CompactTextString(m) }
+func (*DirectMessagePush) ProtoMessage()    {}
+func (*DirectMessagePush) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{55}
+}
+
+func (m *DirectMessagePush) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DirectMessagePush.Unmarshal(m, b)
+}
+func (m *DirectMessagePush) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DirectMessagePush.Marshal(b, m, deterministic)
+}
+func (m *DirectMessagePush) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DirectMessagePush.Merge(m, src)
+}
+func (m *DirectMessagePush) XXX_Size() int {
+	return xxx_messageInfo_DirectMessagePush.Size(m)
+}
+func (m *DirectMessagePush) XXX_DiscardUnknown() {
+	xxx_messageInfo_DirectMessagePush.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DirectMessagePush proto.InternalMessageInfo
+
+func (m *DirectMessagePush) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DirectMessagePush) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *DirectMessagePush) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DirectMessagePush) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *DirectMessagePush) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *DirectMessagePush) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+// Queued match formed, user is dequeued
+type MatchFoundPush struct {
+	MatchId              string         `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	Region               string         `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Players              []*MatchPlayer `protobuf:"bytes,3,rep,name=players,proto3" json:"players,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *MatchFoundPush) Reset()         { *m = MatchFoundPush{} }
+func (m *MatchFoundPush) String() string { return proto.CompactTextString(m) }
+func (*MatchFoundPush) ProtoMessage()    {}
+func (*MatchFoundPush) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{56}
+}
+
+func (m *MatchFoundPush) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MatchFoundPush.Unmarshal(m, b)
+}
+func (m *MatchFoundPush) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MatchFoundPush.Marshal(b, m, deterministic)
+}
+func (m *MatchFoundPush) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatchFoundPush.Merge(m, src)
+}
+func (m *MatchFoundPush) XXX_Size() int {
+	return xxx_messageInfo_MatchFoundPush.Size(m)
+}
+func (m *MatchFoundPush) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatchFoundPush.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MatchFoundPush proto.InternalMessageInfo
+
+func (m *MatchFoundPush) GetMatchId() string {
+	if m != nil {
+		return m.MatchId
+	}
+	return ""
+}
+
+func (m *MatchFoundPush) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *MatchFoundPush) GetPlayers() []*MatchPlayer {
+	if m != nil {
+		return m.Players
+	}
+	return nil
+}
+
+type MatchPlayer struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Rating               int32    `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MatchPlayer) Reset()         { *m = MatchPlayer{} }
+func (m *MatchPlayer) String() string { return proto.CompactTextString(m) }
+func (*MatchPlayer) ProtoMessage()    {}
+func (*MatchPlayer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{57}
+}
+
+func (m *MatchPlayer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MatchPlayer.Unmarshal(m, b)
+}
+func (m *MatchPlayer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MatchPlayer.Marshal(b, m, deterministic)
+}
+func (m *MatchPlayer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatchPlayer.Merge(m, src)
+}
+func (m *MatchPlayer) XXX_Size() int {
+	return xxx_messageInfo_MatchPlayer.Size(m)
+}
+func (m *MatchPlayer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatchPlayer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MatchPlayer proto.InternalMessageInfo
+
+func (m *MatchPlayer) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *MatchPlayer) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MatchPlayer) GetRating() int32 {
+	if m != nil {
+		return m.Rating
+	}
+	return 0
+}
+
+// Room members, ready or started changed
+type RoomUpdatePush struct {
+	Room                 *RoomInfo `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *RoomUpdatePush) Reset()         { *m = RoomUpdatePush{} }
+func (m *RoomUpdatePush) String() string { return proto.CompactTextString(m) }
+func (*RoomUpdatePush) ProtoMessage()    {}
+func (*RoomUpdatePush) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{58}
+}
+
+func (m *RoomUpdatePush) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoomUpdatePush.Unmarshal(m, b)
+}
+func (m *RoomUpdatePush) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoomUpdatePush.Marshal(b, m, deterministic)
+}
+func (m *RoomUpdatePush) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoomUpdatePush.Merge(m, src)
+}
+func (m *RoomUpdatePush) XXX_Size() int {
+	return xxx_messageInfo_RoomUpdatePush.Size(m)
+}
+func (m *RoomUpdatePush) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoomUpdatePush.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoomUpdatePush proto.InternalMessageInfo
+
+func (m *RoomUpdatePush) GetRoom() *RoomInfo {
+	if m != nil {
+		return m.Room
+	}
+	return nil
+}
+
+// Players changed since last snapshot, full one after join
+type RoomSnapshotPush struct {
+	RoomId               string             `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Tick                 uint64             `protobuf:"varint,2,opt,name=tick,proto3" json:"tick,omitempty"`
+	Full                 bool               `protobuf:"varint,3,opt,name=full,proto3" json:"full,omitempty"`
+	Players              []*RoomPlayerState `protobuf:"bytes,4,rep,name=players,proto3" json:"players,omitempty"`
+	Removed              []string           `protobuf:"bytes,5,rep,name=removed,proto3" json:"removed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *RoomSnapshotPush) Reset()         { *m = RoomSnapshotPush{} }
+func (m *RoomSnapshotPush) String() string { return proto.CompactTextString(m) }
+func (*RoomSnapshotPush) ProtoMessage()    {}
+func (*RoomSnapshotPush) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{59}
+}
+
+func (m *RoomSnapshotPush) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoomSnapshotPush.Unmarshal(m, b)
+}
+func (m *RoomSnapshotPush) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoomSnapshotPush.Marshal(b, m, deterministic)
+}
+func (m *RoomSnapshotPush) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoomSnapshotPush.Merge(m, src)
+}
+func (m *RoomSnapshotPush) XXX_Size() int {
+	return xxx_messageInfo_RoomSnapshotPush.Size(m)
+}
+func (m *RoomSnapshotPush) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoomSnapshotPush.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoomSnapshotPush proto.InternalMessageInfo
+
+func (m *RoomSnapshotPush) GetRoomId() string {
+	if m != nil {
+		return m.RoomId
+	}
+	return ""
+}
+
+func (m *RoomSnapshotPush) GetTick() uint64 {
+	if m != nil {
+		return m.Tick
+	}
+	return 0
+}
+
+func (m *RoomSnapshotPush) GetFull() bool {
+	if m != nil {
+		return m.Full
+	}
+	return false
+}
+
+func (m *RoomSnapshotPush) GetPlayers() []*RoomPlayerState {
+	if m != nil {
+		return m.Players
+	}
+	return nil
+}
+
+func (m *RoomSnapshotPush) GetRemoved() []string {
+	if m != nil {
+		return m.Removed
+	}
+	return nil
+}
+
+// First push of each connection, not sequenced
+type SessionPush struct {
+	Resumed              bool     `protobuf:"varint,1,opt,name=resumed,proto3" json:"resumed,omitempty"`
+	Lost                 bool     `protobuf:"varint,2,opt,name=lost,proto3" json:"lost,omitempty"`
+	Seq                  uint64   `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SessionPush) Reset()         { *m = SessionPush{} }
+func (m *SessionPush) String() string { return proto.CompactTextString(m) }
+func (*SessionPush) ProtoMessage()    {}
+func (*SessionPush) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{60}
+}
+
+func (m *SessionPush) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SessionPush.Unmarshal(m, b)
+}
+func (m *SessionPush) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SessionPush.Marshal(b, m, deterministic)
+}
+func (m *SessionPush) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionPush.Merge(m, src)
+}
+func (m *SessionPush) XXX_Size() int {
+	return xxx_messageInfo_SessionPush.Size(m)
+}
+func (m *SessionPush) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionPush.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionPush proto.InternalMessageInfo
+
+func (m *SessionPush) GetResumed() bool {
+	if m != nil {
+		return m.Resumed
+	}
+	return false
+}
+
+func (m *SessionPush) GetLost() bool {
+	if m != nil {
+		return m.Lost
+	}
+	return false
+}
+
+func (m *SessionPush) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+type RoomPlayerState struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	X                    float32  `protobuf:"fixed32,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y                    float32  `protobuf:"fixed32,3,opt,name=y,proto3" json:"y,omitempty"`
+	Seq                  uint32   `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RoomPlayerState) Reset()         { *m = RoomPlayerState{} }
+func (m *RoomPlayerState) String() string { return proto.CompactTextString(m) }
+func (*RoomPlayerState) ProtoMessage()    {}
+func (*RoomPlayerState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{61}
+}
+
+func (m *RoomPlayerState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoomPlayerState.Unmarshal(m, b)
+}
+func (m *RoomPlayerState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoomPlayerState.Marshal(b, m, deterministic)
+}
+func (m *RoomPlayerState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoomPlayerState.Merge(m, src)
+}
+func (m *RoomPlayerState) XXX_Size() int {
+	return xxx_messageInfo_RoomPlayerState.Size(m)
+}
+func (m *RoomPlayerState) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoomPlayerState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoomPlayerState proto.InternalMessageInfo
+
+func (m *RoomPlayerState) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *RoomPlayerState) GetX() float32 {
+	if m != nil {
+		return m.X
+	}
+	return 0
+}
+
+func (m *RoomPlayerState) GetY() float32 {
+	if m != nil {
+		return m.Y
+	}
+	return 0
+}
+
+func (m *RoomPlayerState) GetSeq() uint32 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+// Received friend request
+type FriendRequestPush struct {
+	From                 *Friend  `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FriendRequestPush) Reset()         { *m = FriendRequestPush{} }
+func (m *FriendRequestPush) String() string { return proto.CompactTextString(m) }
+func (*FriendRequestPush) ProtoMessage()    {}
+func (*FriendRequestPush) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{62}
+}
+
+func (m *FriendRequestPush) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FriendRequestPush.Unmarshal(m, b)
+}
+func (m *FriendRequestPush) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FriendRequestPush.Marshal(b, m, deterministic)
+}
+func (m *FriendRequestPush) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FriendRequestPush.Merge(m, src)
+}
+func (m *FriendRequestPush) XXX_Size() int {
+	return xxx_messageInfo_FriendRequestPush.Size(m)
+}
+func (m *FriendRequestPush) XXX_DiscardUnknown() {
+	xxx_messageInfo_FriendRequestPush.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetUserInfoRsp proto.InternalMessageInfo
+var xxx_messageInfo_FriendRequestPush proto.InternalMessageInfo
 
-func (m *GetUserInfoRsp) GetUser() *User {
+func (m *FriendRequestPush) GetFrom() *Friend {
 	if m != nil {
-		return m.User
+		return m.From
 	}
 	return nil
 }
 
-type Notify struct {
-	// Types that are valid to be assigned to Notify:
-	//	*Notify_ChatNotify
-	Notify               isNotify_Notify `protobuf_oneof:"notify"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+// Sent friend request accepted
+type FriendAcceptPush struct {
+	Friend               *Friend  `protobuf:"bytes,1,opt,name=friend,proto3" json:"friend,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Notify) Reset()         { *m = Notify{} }
-func (m *Notify) String() string { return proto.CompactTextString(m) }
-func (*Notify) ProtoMessage()    {}
-func (*Notify) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33c57e4bae7b9afd, []int{6}
+func (m *FriendAcceptPush) Reset()         { *m = FriendAcceptPush{} }
+func (m *FriendAcceptPush) String() string { return proto.CompactTextString(m) }
+func (*FriendAcceptPush) ProtoMessage()    {}
+func (*FriendAcceptPush) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{63}
 }
 
-func (m *Notify) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Notify.Unmarshal(m, b)
+func (m *FriendAcceptPush) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FriendAcceptPush.Unmarshal(m, b)
 }
-func (m *Notify) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Notify.Marshal(b, m, deterministic)
+func (m *FriendAcceptPush) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FriendAcceptPush.Marshal(b, m, deterministic)
 }
-func (m *Notify) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Notify.Merge(m, src)
+func (m *FriendAcceptPush) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FriendAcceptPush.Merge(m, src)
 }
-func (m *Notify) XXX_Size() int {
-	return xxx_messageInfo_Notify.Size(m)
+func (m *FriendAcceptPush) XXX_Size() int {
+	return xxx_messageInfo_FriendAcceptPush.Size(m)
 }
-func (m *Notify) XXX_DiscardUnknown() {
-	xxx_messageInfo_Notify.DiscardUnknown(m)
+func (m *FriendAcceptPush) XXX_DiscardUnknown() {
+	xxx_messageInfo_FriendAcceptPush.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Notify proto.InternalMessageInfo
+var xxx_messageInfo_FriendAcceptPush proto.InternalMessageInfo
 
-type isNotify_Notify interface {
-	isNotify_Notify()
+func (m *FriendAcceptPush) GetFriend() *Friend {
+	if m != nil {
+		return m.Friend
+	}
+	return nil
 }
 
-type Notify_ChatNotify struct {
-	ChatNotify *ChatNotify `protobuf:"bytes,1,opt,name=chatNotify,proto3,oneof"`
+// Friend online or offline
+type FriendPresencePush struct {
+	Friend               *Friend  `protobuf:"bytes,1,opt,name=friend,proto3" json:"friend,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (*Notify_ChatNotify) isNotify_Notify() {}
+func (m *FriendPresencePush) Reset()         { *m = FriendPresencePush{} }
+func (m *FriendPresencePush) String() string { return proto.CompactTextString(m) }
+func (*FriendPresencePush) ProtoMessage()    {}
+func (*FriendPresencePush) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{64}
+}
 
-func (m *Notify) GetNotify() isNotify_Notify {
+func (m *FriendPresencePush) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FriendPresencePush.Unmarshal(m, b)
+}
+func (m *FriendPresencePush) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FriendPresencePush.Marshal(b, m, deterministic)
+}
+func (m *FriendPresencePush) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FriendPresencePush.Merge(m, src)
+}
+func (m *FriendPresencePush) XXX_Size() int {
+	return xxx_messageInfo_FriendPresencePush.Size(m)
+}
+func (m *FriendPresencePush) XXX_DiscardUnknown() {
+	xxx_messageInfo_FriendPresencePush.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FriendPresencePush proto.InternalMessageInfo
+
+func (m *FriendPresencePush) GetFriend() *Friend {
 	if m != nil {
-		return m.Notify
+		return m.Friend
 	}
 	return nil
 }
 
-func (m *Notify) GetChatNotify() *ChatNotify {
-	if x, ok := m.GetNotify().(*Notify_ChatNotify); ok {
-		return x.ChatNotify
+type SystemPush struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SystemPush) Reset()         { *m = SystemPush{} }
+func (m *SystemPush) String() string { return proto.CompactTextString(m) }
+func (*SystemPush) ProtoMessage()    {}
+func (*SystemPush) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{65}
+}
+
+func (m *SystemPush) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemPush.Unmarshal(m, b)
+}
+func (m *SystemPush) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemPush.Marshal(b, m, deterministic)
+}
+func (m *SystemPush) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemPush.Merge(m, src)
+}
+func (m *SystemPush) XXX_Size() int {
+	return xxx_messageInfo_SystemPush.Size(m)
+}
+func (m *SystemPush) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemPush.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemPush proto.InternalMessageInfo
+
+func (m *SystemPush) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	return nil
+	return ""
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*Notify) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*Notify_ChatNotify)(nil),
+func (m *SystemPush) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
+	return ""
 }
 
-type ChatNotify struct {
-	Message              string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+type String struct {
+	Value                string   `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChatNotify) Reset()         { *m = ChatNotify{} }
-func (m *ChatNotify) String() string { return proto.CompactTextString(m) }
-func (*ChatNotify) ProtoMessage()    {}
-func (*ChatNotify) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33c57e4bae7b9afd, []int{7}
+func (m *String) Reset()         { *m = String{} }
+func (m *String) String() string { return proto.CompactTextString(m) }
+func (*String) ProtoMessage()    {}
+func (*String) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{66}
 }
 
-func (m *ChatNotify) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChatNotify.Unmarshal(m, b)
+func (m *String) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_String.Unmarshal(m, b)
 }
-func (m *ChatNotify) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChatNotify.Marshal(b, m, deterministic)
+func (m *String) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_String.Marshal(b, m, deterministic)
 }
-func (m *ChatNotify) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChatNotify.Merge(m, src)
+func (m *String) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_String.Merge(m, src)
 }
-func (m *ChatNotify) XXX_Size() int {
-	return xxx_messageInfo_ChatNotify.Size(m)
+func (m *String) XXX_Size() int {
+	return xxx_messageInfo_String.Size(m)
 }
-func (m *ChatNotify) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChatNotify.DiscardUnknown(m)
+func (m *String) XXX_DiscardUnknown() {
+	xxx_messageInfo_String.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChatNotify proto.InternalMessageInfo
+var xxx_messageInfo_String proto.InternalMessageInfo
 
-func (m *ChatNotify) GetMessage() string {
+func (m *String) GetValue() string {
 	if m != nil {
-		return m.Message
+		return m.Value
 	}
 	return ""
 }
 
-type Push struct {
-	// Types that are valid to be assigned to Push:
-	//	*Push_ChatPush
-	Push                 isPush_Push `protobuf_oneof:"push"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+type User struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email                string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	CreatedAt            string   `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            string   `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Profile              *Profile `protobuf:"bytes,5,opt,name=profile,proto3" json:"profile,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Push) Reset()         { *m = Push{} }
-func (m *Push) String() string { return proto.CompactTextString(m) }
-func (*Push) ProtoMessage()    {}
-func (*Push) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33c57e4bae7b9afd, []int{8}
+func (m *User) Reset()         { *m = User{} }
+func (m *User) String() string { return proto.CompactTextString(m) }
+func (*User) ProtoMessage()    {}
+func (*User) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{67}
 }
 
-func (m *Push) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Push.Unmarshal(m, b)
+func (m *User) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_User.Unmarshal(m, b)
 }
-func (m *Push) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Push.Marshal(b, m, deterministic)
+func (m *User) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_User.Marshal(b, m, deterministic)
 }
-func (m *Push) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Push.Merge(m, src)
+func (m *User) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_User.Merge(m, src)
 }
-func (m *Push) XXX_Size() int {
-	return xxx_messageInfo_Push.Size(m)
+func (m *User) XXX_Size() int {
+	return xxx_messageInfo_User.Size(m)
 }
-func (m *Push) XXX_DiscardUnknown() {
-	xxx_messageInfo_Push.DiscardUnknown(m)
+func (m *User) XXX_DiscardUnknown() {
+	xxx_messageInfo_User.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Push proto.InternalMessageInfo
+var xxx_messageInfo_User proto.InternalMessageInfo
 
-type isPush_Push interface {
-	isPush_Push()
+func (m *User) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
 }
 
-type Push_ChatPush struct {
-	ChatPush *ChatPush `protobuf:"bytes,1,opt,name=chatPush,proto3,oneof"`
+func (m *User) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
 }
 
-func (*Push_ChatPush) isPush_Push() {}
+func (m *User) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
 
-func (m *Push) GetPush() isPush_Push {
+func (m *User) GetUpdatedAt() string {
 	if m != nil {
-		return m.Push
+		return m.UpdatedAt
 	}
-	return nil
+	return ""
 }
 
-func (m *Push) GetChatPush() *ChatPush {
-	if x, ok := m.GetPush().(*Push_ChatPush); ok {
-		return x.ChatPush
+func (m *User) GetProfile() *Profile {
+	if m != nil {
+		return m.Profile
 	}
 	return nil
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*Push) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*Push_ChatPush)(nil),
-	}
+type Empty struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type ChatPush struct {
-	Message              string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+func (m *Empty) Reset()         { *m = Empty{} }
+func (m *Empty) String() string { return proto.CompactTextString(m) }
+func (*Empty) ProtoMessage()    {}
+func (*Empty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{68}
+}
+
+func (m *Empty) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Empty.Unmarshal(m, b)
+}
+func (m *Empty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Empty.Marshal(b, m, deterministic)
+}
+func (m *Empty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Empty.Merge(m, src)
+}
+func (m *Empty) XXX_Size() int {
+	return xxx_messageInfo_Empty.Size(m)
+}
+func (m *Empty) XXX_DiscardUnknown() {
+	xxx_messageInfo_Empty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Empty proto.InternalMessageInfo
+
+type UpdateProfileArg struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Nickname             string   `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Avatar               int32    `protobuf:"varint,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Bio                  string   `protobuf:"bytes,4,opt,name=bio,proto3" json:"bio,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChatPush) Reset()         { *m = ChatPush{} }
-func (m *ChatPush) String() string { return proto.CompactTextString(m) }
-func (*ChatPush) ProtoMessage()    {}
-func (*ChatPush) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33c57e4bae7b9afd, []int{9}
+func (m *UpdateProfileArg) Reset()         { *m = UpdateProfileArg{} }
+func (m *UpdateProfileArg) String() string { return proto.CompactTextString(m) }
+func (*UpdateProfileArg) ProtoMessage()    {}
+func (*UpdateProfileArg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{69}
 }
 
-func (m *ChatPush) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChatPush.Unmarshal(m, b)
+func (m *UpdateProfileArg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateProfileArg.Unmarshal(m, b)
 }
-func (m *ChatPush) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChatPush.Marshal(b, m, deterministic)
+func (m *UpdateProfileArg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateProfileArg.Marshal(b, m, deterministic)
 }
-func (m *ChatPush) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChatPush.Merge(m, src)
+func (m *UpdateProfileArg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateProfileArg.Merge(m, src)
+}
+func (m *UpdateProfileArg) XXX_Size() int {
+	return xxx_messageInfo_UpdateProfileArg.Size(m)
+}
+func (m *UpdateProfileArg) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateProfileArg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateProfileArg proto.InternalMessageInfo
+
+func (m *UpdateProfileArg) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *UpdateProfileArg) GetNickname() string {
+	if m != nil {
+		return m.Nickname
+	}
+	return ""
+}
+
+func (m *UpdateProfileArg) GetAvatar() int32 {
+	if m != nil {
+		return m.Avatar
+	}
+	return 0
+}
+
+func (m *UpdateProfileArg) GetBio() string {
+	if m != nil {
+		return m.Bio
+	}
+	return ""
+}
+
+type SubmitScoreArg struct {
+	Board                string   `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	Uid                  string   `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Score                int64    `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubmitScoreArg) Reset()         { *m = SubmitScoreArg{} }
+func (m *SubmitScoreArg) String() string { return proto.CompactTextString(m) }
+func (*SubmitScoreArg) ProtoMessage()    {}
+func (*SubmitScoreArg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{70}
+}
+
+func (m *SubmitScoreArg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubmitScoreArg.Unmarshal(m, b)
+}
+func (m *SubmitScoreArg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubmitScoreArg.Marshal(b, m, deterministic)
 }
-func (m *ChatPush) XXX_Size() int {
-	return xxx_messageInfo_ChatPush.Size(m)
+func (m *SubmitScoreArg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitScoreArg.Merge(m, src)
 }
-func (m *ChatPush) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChatPush.DiscardUnknown(m)
+func (m *SubmitScoreArg) XXX_Size() int {
+	return xxx_messageInfo_SubmitScoreArg.Size(m)
+}
+func (m *SubmitScoreArg) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitScoreArg.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChatPush proto.InternalMessageInfo
+var xxx_messageInfo_SubmitScoreArg proto.InternalMessageInfo
 
-func (m *ChatPush) GetMessage() string {
+func (m *SubmitScoreArg) GetBoard() string {
 	if m != nil {
-		return m.Message
+		return m.Board
 	}
 	return ""
 }
 
-type String struct {
-	Value                string   `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+func (m *SubmitScoreArg) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *SubmitScoreArg) GetScore() int64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+type LeaderboardArg struct {
+	Board                string   `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	Uid                  string   `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Limit                int32    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Around               bool     `protobuf:"varint,4,opt,name=around,proto3" json:"around,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *String) Reset()         { *m = String{} }
-func (m *String) String() string { return proto.CompactTextString(m) }
-func (*String) ProtoMessage()    {}
-func (*String) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33c57e4bae7b9afd, []int{10}
+func (m *LeaderboardArg) Reset()         { *m = LeaderboardArg{} }
+func (m *LeaderboardArg) String() string { return proto.CompactTextString(m) }
+func (*LeaderboardArg) ProtoMessage()    {}
+func (*LeaderboardArg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{71}
 }
 
-func (m *String) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_String.Unmarshal(m, b)
+func (m *LeaderboardArg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeaderboardArg.Unmarshal(m, b)
 }
-func (m *String) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_String.Marshal(b, m, deterministic)
+func (m *LeaderboardArg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeaderboardArg.Marshal(b, m, deterministic)
 }
-func (m *String) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_String.Merge(m, src)
+func (m *LeaderboardArg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaderboardArg.Merge(m, src)
 }
-func (m *String) XXX_Size() int {
-	return xxx_messageInfo_String.Size(m)
+func (m *LeaderboardArg) XXX_Size() int {
+	return xxx_messageInfo_LeaderboardArg.Size(m)
 }
-func (m *String) XXX_DiscardUnknown() {
-	xxx_messageInfo_String.DiscardUnknown(m)
+func (m *LeaderboardArg) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaderboardArg.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_String proto.InternalMessageInfo
+var xxx_messageInfo_LeaderboardArg proto.InternalMessageInfo
 
-func (m *String) GetValue() string {
+func (m *LeaderboardArg) GetBoard() string {
 	if m != nil {
-		return m.Value
+		return m.Board
 	}
 	return ""
 }
 
-type User struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Email                string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	CreatedAt            string   `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt            string   `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+func (m *LeaderboardArg) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *LeaderboardArg) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *LeaderboardArg) GetAround() bool {
+	if m != nil {
+		return m.Around
+	}
+	return false
+}
+
+type JoinMatchArg struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Region               string   `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	PartySize            int32    `protobuf:"varint,3,opt,name=party_size,json=partySize,proto3" json:"party_size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *User) Reset()         { *m = User{} }
-func (m *User) String() string { return proto.CompactTextString(m) }
-func (*User) ProtoMessage()    {}
-func (*User) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33c57e4bae7b9afd, []int{11}
+func (m *JoinMatchArg) Reset()         { *m = JoinMatchArg{} }
+func (m *JoinMatchArg) String() string { return proto.CompactTextString(m) }
+func (*JoinMatchArg) ProtoMessage()    {}
+func (*JoinMatchArg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{72}
 }
 
-func (m *User) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_User.Unmarshal(m, b)
+func (m *JoinMatchArg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JoinMatchArg.Unmarshal(m, b)
 }
-func (m *User) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_User.Marshal(b, m, deterministic)
+func (m *JoinMatchArg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JoinMatchArg.Marshal(b, m, deterministic)
 }
-func (m *User) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_User.Merge(m, src)
+func (m *JoinMatchArg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JoinMatchArg.Merge(m, src)
 }
-func (m *User) XXX_Size() int {
-	return xxx_messageInfo_User.Size(m)
+func (m *JoinMatchArg) XXX_Size() int {
+	return xxx_messageInfo_JoinMatchArg.Size(m)
 }
-func (m *User) XXX_DiscardUnknown() {
-	xxx_messageInfo_User.DiscardUnknown(m)
+func (m *JoinMatchArg) XXX_DiscardUnknown() {
+	xxx_messageInfo_JoinMatchArg.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_User proto.InternalMessageInfo
+var xxx_messageInfo_JoinMatchArg proto.InternalMessageInfo
 
-func (m *User) GetId() string {
+func (m *JoinMatchArg) GetUid() string {
 	if m != nil {
-		return m.Id
+		return m.Uid
 	}
 	return ""
 }
 
-func (m *User) GetEmail() string {
+func (m *JoinMatchArg) GetRegion() string {
 	if m != nil {
-		return m.Email
+		return m.Region
 	}
 	return ""
 }
 
-func (m *User) GetCreatedAt() string {
+func (m *JoinMatchArg) GetPartySize() int32 {
 	if m != nil {
-		return m.CreatedAt
+		return m.PartySize
 	}
-	return ""
+	return 0
 }
 
-func (m *User) GetUpdatedAt() string {
+type PushSystemArg struct {
+	Uid                  string      `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Push                 *SystemPush `protobuf:"bytes,2,opt,name=push,proto3" json:"push,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *PushSystemArg) Reset()         { *m = PushSystemArg{} }
+func (m *PushSystemArg) String() string { return proto.CompactTextString(m) }
+func (*PushSystemArg) ProtoMessage()    {}
+func (*PushSystemArg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{73}
+}
+
+func (m *PushSystemArg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PushSystemArg.Unmarshal(m, b)
+}
+func (m *PushSystemArg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PushSystemArg.Marshal(b, m, deterministic)
+}
+func (m *PushSystemArg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushSystemArg.Merge(m, src)
+}
+func (m *PushSystemArg) XXX_Size() int {
+	return xxx_messageInfo_PushSystemArg.Size(m)
+}
+func (m *PushSystemArg) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushSystemArg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PushSystemArg proto.InternalMessageInfo
+
+func (m *PushSystemArg) GetUid() string {
 	if m != nil {
-		return m.UpdatedAt
+		return m.Uid
 	}
 	return ""
 }
 
+func (m *PushSystemArg) GetPush() *SystemPush {
+	if m != nil {
+		return m.Push
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("pb.ErrorCode", ErrorCode_name, ErrorCode_value)
 	proto.RegisterType((*Message)(nil), "pb.Message")
 	proto.RegisterType((*Req)(nil), "pb.Req")
 	proto.RegisterType((*GetUserInfoReq)(nil), "pb.GetUserInfoReq")
+	proto.RegisterType((*JoinChannelReq)(nil), "pb.JoinChannelReq")
+	proto.RegisterType((*LeaveChannelReq)(nil), "pb.LeaveChannelReq")
+	proto.RegisterType((*GetChatHistoryReq)(nil), "pb.GetChatHistoryReq")
+	proto.RegisterType((*SendFriendReq)(nil), "pb.SendFriendReq")
+	proto.RegisterType((*AcceptFriendReq)(nil), "pb.AcceptFriendReq")
+	proto.RegisterType((*DeclineFriendReq)(nil), "pb.DeclineFriendReq")
+	proto.RegisterType((*RemoveFriendReq)(nil), "pb.RemoveFriendReq")
+	proto.RegisterType((*GetFriendListReq)(nil), "pb.GetFriendListReq")
+	proto.RegisterType((*GetProfileReq)(nil), "pb.GetProfileReq")
+	proto.RegisterType((*SubmitScoreReq)(nil), "pb.SubmitScoreReq")
+	proto.RegisterType((*GetLeaderboardReq)(nil), "pb.GetLeaderboardReq")
+	proto.RegisterType((*JoinMatchReq)(nil), "pb.JoinMatchReq")
+	proto.RegisterType((*CancelMatchReq)(nil), "pb.CancelMatchReq")
+	proto.RegisterType((*CreateRoomReq)(nil), "pb.CreateRoomReq")
+	proto.RegisterType((*JoinRoomReq)(nil), "pb.JoinRoomReq")
+	proto.RegisterType((*LeaveRoomReq)(nil), "pb.LeaveRoomReq")
+	proto.RegisterType((*ReadyRoomReq)(nil), "pb.ReadyRoomReq")
+	proto.RegisterType((*UpdateProfileReq)(nil), "pb.UpdateProfileReq")
 	proto.RegisterType((*Rsp)(nil), "pb.Rsp")
 	proto.RegisterType((*Error)(nil), "pb.Error")
 	proto.RegisterType((*GetUserInfoRsp)(nil), "pb.GetUserInfoRsp")
+	proto.RegisterType((*JoinChannelRsp)(nil), "pb.JoinChannelRsp")
+	proto.RegisterType((*LeaveChannelRsp)(nil), "pb.LeaveChannelRsp")
+	proto.RegisterType((*GetChatHistoryRsp)(nil), "pb.GetChatHistoryRsp")
+	proto.RegisterType((*SendFriendRsp)(nil), "pb.SendFriendRsp")
+	proto.RegisterType((*AcceptFriendRsp)(nil), "pb.AcceptFriendRsp")
+	proto.RegisterType((*DeclineFriendRsp)(nil), "pb.DeclineFriendRsp")
+	proto.RegisterType((*RemoveFriendRsp)(nil), "pb.RemoveFriendRsp")
+	proto.RegisterType((*GetFriendListRsp)(nil), "pb.GetFriendListRsp")
+	proto.RegisterType((*GetProfileRsp)(nil), "pb.GetProfileRsp")
+	proto.RegisterType((*UpdateProfileRsp)(nil), "pb.UpdateProfileRsp")
+	proto.RegisterType((*Profile)(nil), "pb.Profile")
+	proto.RegisterType((*SubmitScoreRsp)(nil), "pb.SubmitScoreRsp")
+	proto.RegisterType((*GetLeaderboardRsp)(nil), "pb.GetLeaderboardRsp")
+	proto.RegisterType((*JoinMatchRsp)(nil), "pb.JoinMatchRsp")
+	proto.RegisterType((*CancelMatchRsp)(nil), "pb.CancelMatchRsp")
+	proto.RegisterType((*CreateRoomRsp)(nil), "pb.CreateRoomRsp")
+	proto.RegisterType((*JoinRoomRsp)(nil), "pb.JoinRoomRsp")
+	proto.RegisterType((*LeaveRoomRsp)(nil), "pb.LeaveRoomRsp")
+	proto.RegisterType((*ReadyRoomRsp)(nil), "pb.ReadyRoomRsp")
+	proto.RegisterType((*RoomInfo)(nil), "pb.RoomInfo")
+	proto.RegisterType((*RoomMember)(nil), "pb.RoomMember")
+	proto.RegisterType((*Leaderboard)(nil), "pb.Leaderboard")
+	proto.RegisterType((*LeaderboardEntry)(nil), "pb.LeaderboardEntry")
+	proto.RegisterType((*Friend)(nil), "pb.Friend")
 	proto.RegisterType((*Notify)(nil), "pb.Notify")
+	proto.RegisterType((*AckNotify)(nil), "pb.AckNotify")
 	proto.RegisterType((*ChatNotify)(nil), "pb.ChatNotify")
+	proto.RegisterType((*RoomInputNotify)(nil), "pb.RoomInputNotify")
+	proto.RegisterType((*DirectMessageNotify)(nil), "pb.DirectMessageNotify")
 	proto.RegisterType((*Push)(nil), "pb.Push")
 	proto.RegisterType((*ChatPush)(nil), "pb.ChatPush")
+	proto.RegisterType((*DirectMessagePush)(nil), "pb.DirectMessagePush")
+	proto.RegisterType((*MatchFoundPush)(nil), "pb.MatchFoundPush")
+	proto.RegisterType((*MatchPlayer)(nil), "pb.MatchPlayer")
+	proto.RegisterType((*RoomUpdatePush)(nil), "pb.RoomUpdatePush")
+	proto.RegisterType((*RoomSnapshotPush)(nil), "pb.RoomSnapshotPush")
+	proto.RegisterType((*SessionPush)(nil), "pb.SessionPush")
+	proto.RegisterType((*RoomPlayerState)(nil), "pb.RoomPlayerState")
+	proto.RegisterType((*FriendRequestPush)(nil), "pb.FriendRequestPush")
+	proto.RegisterType((*FriendAcceptPush)(nil), "pb.FriendAcceptPush")
+	proto.RegisterType((*FriendPresencePush)(nil), "pb.FriendPresencePush")
+	proto.RegisterType((*SystemPush)(nil), "pb.SystemPush")
 	proto.RegisterType((*String)(nil), "pb.String")
 	proto.RegisterType((*User)(nil), "pb.User")
+	proto.RegisterType((*Empty)(nil), "pb.Empty")
+	proto.RegisterType((*UpdateProfileArg)(nil), "pb.UpdateProfileArg")
+	proto.RegisterType((*SubmitScoreArg)(nil), "pb.SubmitScoreArg")
+	proto.RegisterType((*LeaderboardArg)(nil), "pb.LeaderboardArg")
+	proto.RegisterType((*JoinMatchArg)(nil), "pb.JoinMatchArg")
+	proto.RegisterType((*PushSystemArg)(nil), "pb.PushSystemArg")
 }
 
 func init() { proto.RegisterFile("message.proto", fileDescriptor_33c57e4bae7b9afd) }
 
 var fileDescriptor_33c57e4bae7b9afd = []byte{
-	// 453 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x4f, 0x8f, 0xd3, 0x30,
-	0x10, 0xc5, 0xd3, 0x26, 0x4d, 0x9b, 0x29, 0x54, 0x2b, 0x8b, 0x43, 0xc4, 0x9f, 0x15, 0x6b, 0x56,
-	0x08, 0x71, 0xa8, 0x50, 0xb9, 0x21, 0x2e, 0x5b, 0x40, 0x1b, 0x0e, 0x20, 0xe4, 0x15, 0x37, 0x24,
-	0x94, 0x36, 0xb3, 0x6d, 0xd0, 0xa6, 0x71, 0x6c, 0x67, 0x25, 0x4e, 0x7c, 0x09, 0x3e, 0x30, 0xf2,
-	0xd8, 0x09, 0x6d, 0x41, 0x7b, 0x8b, 0xdf, 0xef, 0xc9, 0xf3, 0x32, 0x2f, 0x81, 0xfb, 0x15, 0x6a,
-	0x9d, 0x6f, 0x70, 0x2e, 0x55, 0x6d, 0x6a, 0x36, 0x94, 0x2b, 0xfe, 0x7b, 0x00, 0xe3, 0x4f, 0x4e,
-	0x65, 0x8f, 0x20, 0x54, 0xd8, 0xa4, 0x83, 0xa7, 0x83, 0x17, 0xd3, 0xc5, 0x78, 0x2e, 0x57, 0x73,
-	0x81, 0x4d, 0x16, 0x08, 0xab, 0x12, 0xd4, 0x32, 0x1d, 0xee, 0x41, 0x2d, 0x09, 0x6a, 0xc9, 0xce,
-	0x21, 0xde, 0xd5, 0xa6, 0xbc, 0xfe, 0x99, 0x86, 0xc4, 0xc1, 0xf2, 0xcf, 0xa4, 0x64, 0x81, 0xf0,
-	0x8c, 0x9d, 0x42, 0x24, 0x5b, 0xbd, 0x4d, 0x23, 0xf2, 0x4c, 0xac, 0xe7, 0x4b, 0xab, 0xb7, 0x59,
-	0x20, 0x48, 0x5f, 0x26, 0x30, 0xf6, 0x01, 0xf9, 0x37, 0x08, 0x05, 0x36, 0xec, 0x04, 0xc2, 0xaa,
-	0x2c, 0x28, 0x51, 0x22, 0xec, 0x23, 0x7b, 0x0b, 0xb3, 0x0d, 0x9a, 0xaf, 0x1a, 0xd5, 0xc7, 0xdd,
-	0x75, 0x2d, 0xb0, 0xf1, 0x89, 0x98, 0xbd, 0xed, 0xf2, 0x80, 0x64, 0x81, 0x38, 0xf2, 0x2e, 0x47,
-	0xf4, 0x86, 0xfc, 0x04, 0x66, 0x87, 0x56, 0xfe, 0x0b, 0x42, 0xa1, 0xe5, 0x7f, 0xe6, 0x9d, 0xc1,
-	0x08, 0x95, 0xaa, 0x95, 0x1f, 0x93, 0xd8, 0x31, 0x1f, 0xac, 0x90, 0x05, 0xc2, 0x91, 0xe3, 0x48,
-	0x5a, 0xfa, 0x25, 0xfc, 0x13, 0x89, 0xf6, 0x75, 0xe4, 0xa5, 0x48, 0x5a, 0xf2, 0x33, 0x18, 0xd1,
-	0xb5, 0x2c, 0xed, 0x97, 0xe0, 0x63, 0xf4, 0x3b, 0x99, 0x1f, 0xa6, 0xd6, 0x92, 0x3d, 0x86, 0xa8,
-	0xd5, 0xa8, 0x7c, 0x63, 0xb4, 0x50, 0x8b, 0x05, 0xa9, 0xfc, 0x3d, 0xc4, 0xae, 0x02, 0xf6, 0x0a,
-	0x60, 0xbd, 0xcd, 0x8d, 0x3b, 0x79, 0xf7, 0xcc, 0xba, 0xdf, 0xf5, 0x6a, 0x16, 0x88, 0x3d, 0xcf,
-	0x72, 0xd2, 0x15, 0xca, 0x9f, 0x03, 0xfc, 0x75, 0xdd, 0x91, 0xee, 0x0d, 0x44, 0xb6, 0x4c, 0xf6,
-	0x12, 0x26, 0xf6, 0x1e, 0xfb, 0xec, 0x27, 0xdd, 0xeb, 0x26, 0xf9, 0xb2, 0x7b, 0xbe, 0x8c, 0xdd,
-	0x07, 0xc1, 0xcf, 0x61, 0xd2, 0xf1, 0x3b, 0x26, 0x9c, 0x42, 0x7c, 0x65, 0x54, 0xb9, 0xdb, 0xb0,
-	0x07, 0x30, 0xba, 0xcd, 0x6f, 0xda, 0xce, 0xe1, 0x0e, 0xfc, 0x07, 0x44, 0xf6, 0xed, 0xd9, 0x0c,
-	0x86, 0x7d, 0x87, 0xc3, 0xb2, 0xb0, 0x6e, 0xac, 0xf2, 0xf2, 0x86, 0x2a, 0x4c, 0x84, 0x3b, 0xb0,
-	0x27, 0x00, 0x6b, 0x85, 0xb9, 0xc1, 0xe2, 0x7b, 0x6e, 0xa8, 0xb1, 0x44, 0x24, 0x5e, 0xb9, 0x30,
-	0x16, 0xb7, 0xb2, 0xe8, 0x70, 0xe4, 0xb0, 0x57, 0x2e, 0xcc, 0x62, 0x01, 0xd3, 0xcb, 0xbc, 0xc2,
-	0x2b, 0x54, 0xb7, 0xe5, 0x1a, 0xd9, 0x33, 0x98, 0xee, 0x55, 0xc3, 0xe8, 0xf3, 0x77, 0x59, 0x1f,
-	0xf6, 0xad, 0xac, 0x62, 0xfa, 0xeb, 0x5e, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x5a, 0x24, 0x44,
-	0x16, 0x86, 0x03, 0x00, 0x00,
+	// 3093 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x5a, 0x5f, 0x73, 0x1b, 0xc7,
+	0x91, 0x27, 0xfe, 0x03, 0x0d, 0xfe, 0x59, 0x0e, 0x29, 0x79, 0xad, 0xb3, 0x7d, 0xf2, 0x5a, 0xf6,
+	0xe9, 0x74, 0x65, 0x9d, 0x2d, 0x97, 0x7d, 0xe7, 0x94, 0xab, 0x12, 0x90, 0x58, 0x12, 0x90, 0x40,
+	0x80, 0x1e, 0x90, 0x96, 0x95, 0x3c, 0x20, 0x4b, 0x60, 0x48, 0xc2, 0x22, 0xb0, 0xab, 0x9d, 0xa5,
+	0x22, 0x3a, 0x7f, 0x9d, 0x97, 0xe4, 0x2d, 0x95, 0xaa, 0xe4, 0x2d, 0x6f, 0xf9, 0x24, 0xf9, 0x2e,
+	0xf9, 0x12, 0x79, 0x4b, 0xf5, 0xcc, 0xce, 0xce, 0xcc, 0x02, 0x54, 0xe8, 0xbc, 0xed, 0xf4, 0xaf,
+	0xbb, 0xb7, 0xa7, 0x77, 0xa6, 0xfb, 0x37, 0x03, 0xc0, 0xda, 0x8c, 0x71, 0x1e, 0x9c, 0xb1, 0x87,
+	0x51, 0x1c, 0x26, 0x21, 0x29, 0x46, 0x27, 0xde, 0x9f, 0x0a, 0x50, 0x3b, 0x90, 0x52, 0xf2, 0x1f,
+	0x50, 0x8a, 0xd9, 0x0b, 0xb7, 0x70, 0xb7, 0x70, 0xbf, 0xf9, 0xa8, 0xf6, 0x30, 0x3a, 0x79, 0x48,
+	0xd9, 0x8b, 0xce, 0x0a, 0x45, 0xa9, 0x00, 0x79, 0xe4, 0x16, 0x0d, 0x90, 0x47, 0x02, 0xe4, 0x11,
+	0xb9, 0x07, 0xd5, 0x79, 0x98, 0x4c, 0x4f, 0xaf, 0xdc, 0x92, 0xc0, 0x01, 0xf1, 0xbe, 0x90, 0x74,
+	0x56, 0x68, 0x8a, 0x91, 0x77, 0xa0, 0x1c, 0x5d, 0xf2, 0x73, 0xb7, 0x2c, 0x74, 0xea, 0xa8, 0x73,
+	0x78, 0xc9, 0xcf, 0x3b, 0x2b, 0x54, 0xc8, 0x77, 0x1a, 0x50, 0x4b, 0x03, 0xf4, 0xfe, 0xd6, 0x80,
+	0x12, 0x65, 0x2f, 0x88, 0x03, 0xa5, 0xd9, 0x74, 0x22, 0x42, 0x6a, 0x50, 0x7c, 0x24, 0x5f, 0xc0,
+	0xfa, 0x19, 0x4b, 0x8e, 0x39, 0x8b, 0xbb, 0xf3, 0xd3, 0x90, 0xb2, 0x17, 0x69, 0x48, 0x04, 0xdd,
+	0xed, 0x5b, 0x48, 0x67, 0x85, 0xe6, 0x74, 0xd1, 0xfa, 0x9b, 0x70, 0x3a, 0xdf, 0x3d, 0x0f, 0xe6,
+	0x73, 0x76, 0x81, 0xd6, 0x25, 0x6d, 0xfd, 0xd8, 0x42, 0xd0, 0xda, 0xd6, 0x25, 0x3f, 0x84, 0x8d,
+	0x0b, 0x16, 0xbc, 0x64, 0x86, 0xb9, 0x9c, 0xcb, 0x16, 0x9a, 0xf7, 0x6c, 0xa8, 0xb3, 0x42, 0xf3,
+	0xda, 0xc4, 0x87, 0xcd, 0x33, 0x96, 0xec, 0x9e, 0x07, 0x49, 0x67, 0xca, 0x93, 0x30, 0xbe, 0x42,
+	0x17, 0x15, 0xe1, 0xe2, 0x56, 0x1a, 0xbf, 0x0d, 0x76, 0x56, 0xe8, 0xa2, 0x05, 0xf9, 0x1c, 0xd6,
+	0x38, 0x9b, 0x4f, 0xf6, 0xe2, 0x29, 0x9b, 0x4f, 0xd0, 0x45, 0x55, 0xb8, 0xd8, 0x44, 0x17, 0x43,
+	0x13, 0xe8, 0xac, 0x50, 0x5b, 0x13, 0xa7, 0x10, 0x8c, 0xc7, 0x2c, 0x4a, 0xb4, 0x71, 0x4d, 0x4f,
+	0xa1, 0x65, 0x43, 0x38, 0x85, 0x9c, 0x36, 0xd9, 0x01, 0x67, 0xc2, 0xc6, 0x17, 0xd3, 0x39, 0xd3,
+	0x1e, 0xea, 0xc2, 0xc3, 0x36, 0x7a, 0x68, 0xe7, 0xb0, 0xce, 0x0a, 0x5d, 0xd0, 0xc7, 0x20, 0x62,
+	0x36, 0x0b, 0x5f, 0x1a, 0x2e, 0x1a, 0x3a, 0x08, 0x6a, 0x43, 0x18, 0x44, 0x4e, 0x1b, 0x83, 0x38,
+	0x63, 0x69, 0x50, 0xbd, 0x29, 0x4f, 0xd0, 0x03, 0xe8, 0x20, 0xf6, 0x73, 0x18, 0x06, 0x91, 0xd7,
+	0xc7, 0x24, 0x9e, 0xb1, 0xe4, 0x30, 0x0e, 0x4f, 0xa7, 0x17, 0x0c, 0x1d, 0x34, 0x75, 0x12, 0xf7,
+	0x4d, 0x00, 0x93, 0x68, 0x69, 0xe2, 0xeb, 0x2f, 0xa3, 0x49, 0x90, 0x30, 0xc3, 0x7a, 0x55, 0xbf,
+	0xfe, 0x38, 0x87, 0xe1, 0xeb, 0xf3, 0xfa, 0xb8, 0x12, 0xf9, 0xe5, 0xc9, 0x6c, 0x9a, 0x0c, 0xc7,
+	0x61, 0x2c, 0x3c, 0xac, 0xe9, 0x95, 0x38, 0xb4, 0x10, 0x5c, 0x89, 0xb6, 0x6e, 0xba, 0x90, 0x7a,
+	0x2c, 0x98, 0xb0, 0xf8, 0x24, 0x0c, 0x62, 0x91, 0xc3, 0x75, 0x6b, 0x21, 0xd9, 0x60, 0xba, 0x90,
+	0x6c, 0x21, 0xf9, 0x0c, 0x56, 0x71, 0x89, 0x1f, 0x04, 0xc9, 0xf8, 0x1c, 0x3d, 0x6c, 0x08, 0x0f,
+	0x8e, 0xda, 0x0c, 0x4a, 0xde, 0x59, 0xa1, 0x96, 0x1e, 0x06, 0x3f, 0x0e, 0xe6, 0x63, 0x76, 0x91,
+	0x59, 0x3a, 0x3a, 0xf8, 0x5d, 0x0b, 0xc1, 0xe0, 0x6d, 0x5d, 0xcc, 0xfc, 0x38, 0x66, 0x41, 0xc2,
+	0x68, 0x18, 0xce, 0xd0, 0x78, 0x53, 0x67, 0x7e, 0xd7, 0x04, 0x30, 0xf3, 0x96, 0x26, 0xf9, 0x04,
+	0x9a, 0x18, 0x88, 0x32, 0x24, 0xc2, 0x70, 0x43, 0xc5, 0xab, 0xcd, 0x4c, 0x2d, 0x9c, 0xa5, 0xd8,
+	0x88, 0xca, 0x6a, 0x4b, 0xcf, 0xb2, 0x67, 0xc8, 0x71, 0x96, 0xa6, 0x1e, 0xda, 0xc5, 0x2c, 0x98,
+	0x5c, 0x29, 0xbb, 0x6d, 0x6d, 0x47, 0x0d, 0x39, 0xda, 0x99, 0x7a, 0x3b, 0x15, 0x51, 0x47, 0x3d,
+	0x07, 0xd6, 0xed, 0x7a, 0xe4, 0x3d, 0x80, 0x75, 0xbb, 0xc6, 0x10, 0x17, 0x6a, 0x63, 0x39, 0x4a,
+	0x6b, 0x9c, 0x1a, 0x7a, 0xff, 0x03, 0x1b, 0xb9, 0x82, 0xf2, 0x1a, 0xe5, 0x9f, 0xc0, 0xe6, 0x42,
+	0xe9, 0xb8, 0x5e, 0x9d, 0xdc, 0x86, 0xea, 0x09, 0x3b, 0x0d, 0x63, 0x26, 0x6a, 0x67, 0x83, 0xa6,
+	0x23, 0xb2, 0x0d, 0x95, 0x8b, 0xe9, 0x6c, 0x9a, 0x88, 0xa2, 0x58, 0xa1, 0x72, 0xe0, 0xbd, 0x0b,
+	0x6b, 0x56, 0x51, 0xc1, 0xa2, 0x7c, 0xa9, 0x8b, 0xf2, 0xe5, 0x74, 0xe2, 0xbd, 0x07, 0x1b, 0xb9,
+	0xd2, 0xb1, 0x44, 0xe9, 0x1e, 0x38, 0xf9, 0xea, 0xb0, 0xdc, 0x55, 0xae, 0x00, 0x2c, 0x51, 0x22,
+	0xe0, 0xe4, 0xf7, 0x38, 0x86, 0x69, 0x6d, 0xdb, 0x25, 0x66, 0x5f, 0xc0, 0xba, 0xbd, 0xb3, 0x70,
+	0xc6, 0x62, 0x33, 0xa4, 0x5a, 0x72, 0x80, 0x52, 0x3e, 0x56, 0xe9, 0x29, 0x51, 0x39, 0xf0, 0x9e,
+	0x8a, 0x24, 0xe7, 0x76, 0xd0, 0xb5, 0x0e, 0x64, 0x22, 0x8b, 0x46, 0x22, 0x31, 0xed, 0x41, 0x1c,
+	0x5e, 0xce, 0x27, 0x22, 0xbf, 0x75, 0x9a, 0x8e, 0x3c, 0x1f, 0x56, 0xcd, 0xdd, 0x86, 0x7a, 0x31,
+	0x3b, 0x9b, 0x86, 0xf3, 0xd4, 0x69, 0x3a, 0x22, 0x6f, 0x03, 0x44, 0x41, 0x9c, 0x5c, 0x8d, 0xf8,
+	0xf4, 0x5b, 0x96, 0xba, 0x6e, 0x08, 0xc9, 0x70, 0xfa, 0x2d, 0xc3, 0xf5, 0x66, 0x6f, 0x3d, 0xef,
+	0x23, 0x58, 0xb3, 0xf6, 0x13, 0xf9, 0x4f, 0x68, 0xce, 0x82, 0x57, 0xa3, 0xe8, 0x22, 0xb8, 0x62,
+	0x31, 0x17, 0xee, 0x2b, 0x14, 0x66, 0xc1, 0xab, 0x43, 0x29, 0xf1, 0x3e, 0x80, 0xa6, 0xb1, 0x91,
+	0xc8, 0x1b, 0x50, 0x8b, 0xc3, 0x70, 0x36, 0xca, 0xd2, 0x58, 0xc5, 0x61, 0x77, 0xe2, 0xad, 0xc3,
+	0xaa, 0xb9, 0x75, 0xbc, 0x7b, 0xb0, 0x6a, 0x6e, 0x09, 0x4c, 0x80, 0xd8, 0x12, 0xc2, 0xac, 0x4e,
+	0xe5, 0xc0, 0xfb, 0x1a, 0x9c, 0x7c, 0x6d, 0x24, 0x77, 0xa0, 0x3e, 0x9f, 0x8e, 0x9f, 0xcf, 0x83,
+	0x19, 0x4b, 0xdf, 0x91, 0x8d, 0x45, 0xc2, 0x5e, 0x06, 0x49, 0x10, 0xa7, 0x93, 0x4d, 0x47, 0xf8,
+	0x65, 0x4f, 0xa6, 0xa1, 0xc8, 0x62, 0x83, 0xe2, 0xa3, 0xf7, 0x77, 0xe4, 0x0b, 0x3c, 0x5a, 0xc2,
+	0x17, 0xde, 0x85, 0x0a, 0x8b, 0xe3, 0x30, 0x4e, 0x69, 0x42, 0x03, 0x77, 0xaf, 0x8f, 0x82, 0xce,
+	0x0a, 0x95, 0x48, 0x9e, 0x52, 0xf0, 0xc8, 0x24, 0x05, 0xfb, 0x16, 0x92, 0xa7, 0x14, 0x3c, 0xca,
+	0x53, 0x0a, 0x1e, 0xb9, 0x65, 0x6d, 0xfd, 0xd8, 0x42, 0xf2, 0x94, 0x82, 0x47, 0x0b, 0x94, 0x82,
+	0x47, 0x6e, 0x45, 0xb7, 0xc2, 0x9e, 0x0d, 0x2d, 0x50, 0x0a, 0x1e, 0x2d, 0xa1, 0x14, 0x3c, 0x72,
+	0xab, 0x56, 0x27, 0xb0, 0xc1, 0x25, 0x94, 0x82, 0x47, 0x39, 0x4a, 0xc1, 0x23, 0xb7, 0xa6, 0x6b,
+	0xf2, 0xd0, 0x04, 0x72, 0x94, 0x42, 0x4e, 0xc1, 0x22, 0x09, 0x3c, 0x72, 0xeb, 0x7a, 0x0a, 0x2d,
+	0x1b, 0x5a, 0xa0, 0x14, 0x3c, 0x5a, 0xa4, 0x14, 0x3c, 0x72, 0x1b, 0xba, 0x9d, 0xb6, 0x73, 0xd8,
+	0x22, 0xa5, 0x90, 0x41, 0x58, 0x24, 0x81, 0x47, 0x2e, 0xe8, 0x20, 0xa8, 0x0d, 0x2d, 0x50, 0x0a,
+	0x19, 0x84, 0x4d, 0x11, 0x78, 0xe4, 0x36, 0x75, 0x10, 0xfb, 0x39, 0x6c, 0x91, 0x52, 0xc8, 0x24,
+	0x1a, 0x44, 0x81, 0x47, 0xee, 0xaa, 0x4e, 0xe2, 0xbe, 0x09, 0xe4, 0x28, 0x85, 0x7c, 0xbd, 0x4d,
+	0x11, 0x78, 0xe4, 0xae, 0xe9, 0xd7, 0x1f, 0xe7, 0xb0, 0x45, 0x4a, 0x21, 0x57, 0xa2, 0x49, 0x13,
+	0x78, 0xe4, 0xae, 0xeb, 0x95, 0x38, 0xb4, 0x90, 0x3c, 0xa5, 0xc8, 0x16, 0x92, 0x59, 0xde, 0x78,
+	0xe4, 0x6e, 0x58, 0x0b, 0xc9, 0x06, 0x97, 0x50, 0x0a, 0x1e, 0xd9, 0x94, 0x82, 0x47, 0xae, 0xa3,
+	0x9b, 0xe6, 0x63, 0x43, 0x6e, 0x53, 0x0a, 0x19, 0xbc, 0x49, 0x13, 0x78, 0xe4, 0x6e, 0xea, 0xe0,
+	0x77, 0x2d, 0x24, 0x4f, 0x29, 0x64, 0xe6, 0x0d, 0xa2, 0xc0, 0x23, 0x97, 0xe8, 0xcc, 0xef, 0x9a,
+	0x40, 0x8e, 0x52, 0xf0, 0xc8, 0xa2, 0x14, 0x3c, 0x72, 0xb7, 0x96, 0x50, 0x0a, 0x61, 0x66, 0x6a,
+	0xd9, 0x94, 0x82, 0x47, 0x26, 0x35, 0xe8, 0x19, 0x72, 0x9b, 0x52, 0x48, 0x3b, 0x4d, 0x15, 0x78,
+	0xe4, 0xde, 0x5a, 0x46, 0x29, 0xa4, 0x9d, 0xa9, 0x27, 0x28, 0x05, 0x8f, 0xbc, 0x36, 0x54, 0x44,
+	0xed, 0x22, 0x6e, 0x76, 0x54, 0x52, 0xbd, 0x3d, 0x1d, 0x92, 0x77, 0xa1, 0x3c, 0x0e, 0x27, 0xb2,
+	0x3d, 0xac, 0x3f, 0x5a, 0xcb, 0xca, 0xdd, 0x6e, 0x38, 0x61, 0x54, 0x40, 0xde, 0x43, 0x9b, 0x98,
+	0xf0, 0x88, 0xbc, 0x05, 0xe5, 0x4b, 0xce, 0x62, 0xb7, 0xa0, 0x4f, 0x66, 0x08, 0x53, 0x21, 0xcd,
+	0xd3, 0x16, 0x1e, 0x7d, 0x0f, 0xda, 0xf2, 0x5a, 0xe5, 0x70, 0x81, 0xb6, 0xbc, 0x4e, 0x9d, 0xdc,
+	0x87, 0x7a, 0x3a, 0x4b, 0xee, 0x16, 0xef, 0x96, 0xee, 0x37, 0x1f, 0xad, 0x8a, 0xef, 0x7b, 0x1e,
+	0x24, 0x78, 0x8e, 0xa4, 0x19, 0x6a, 0x10, 0x9c, 0x92, 0x49, 0x70, 0x72, 0x54, 0x86, 0x47, 0x4b,
+	0x38, 0xc2, 0xa7, 0x39, 0x2a, 0xc3, 0x23, 0xe2, 0x41, 0xf5, 0x54, 0x0c, 0xdc, 0x82, 0x3e, 0xdd,
+	0xa6, 0x70, 0x8a, 0x2c, 0x92, 0x1b, 0x1e, 0xdd, 0x80, 0xdc, 0x2c, 0x55, 0xfa, 0x69, 0x9e, 0xdc,
+	0x88, 0x03, 0x76, 0x4d, 0xbe, 0x08, 0x9b, 0x76, 0x29, 0x17, 0x83, 0x82, 0xc8, 0x07, 0x50, 0x8f,
+	0xd9, 0x8b, 0x4b, 0xc6, 0x13, 0x95, 0x20, 0x53, 0x2d, 0xc3, 0xbc, 0xcf, 0x2c, 0xaa, 0xc4, 0x23,
+	0xf2, 0x3e, 0xd4, 0x22, 0x39, 0x4a, 0xa7, 0xd8, 0x14, 0x87, 0xf3, 0x54, 0x41, 0x61, 0xde, 0xe7,
+	0xf9, 0xfe, 0x7d, 0x73, 0xd3, 0xbf, 0x16, 0xa0, 0x96, 0x0a, 0x17, 0xa7, 0x4c, 0x08, 0x94, 0x05,
+	0x01, 0x90, 0x74, 0x54, 0x3c, 0x5b, 0xc4, 0xa0, 0x74, 0x2d, 0x31, 0x28, 0x2f, 0x23, 0x06, 0x95,
+	0x8c, 0x18, 0x08, 0x26, 0xc6, 0x5e, 0xb2, 0x0b, 0xb7, 0x9a, 0x32, 0x31, 0x1c, 0xa0, 0x7d, 0x1c,
+	0x24, 0xd3, 0xf9, 0x99, 0x68, 0x73, 0x15, 0x9a, 0x8e, 0xf2, 0x04, 0x91, 0x47, 0xe4, 0x01, 0x54,
+	0xd8, 0x3c, 0x89, 0xaf, 0xdc, 0x82, 0x2e, 0xc6, 0x46, 0xc5, 0xf3, 0x11, 0xa3, 0x52, 0xc5, 0xdb,
+	0x5b, 0x20, 0x88, 0x3c, 0x22, 0x1f, 0x43, 0xf3, 0x42, 0x4b, 0xdc, 0x82, 0x2e, 0x2f, 0xa6, 0xa2,
+	0xa9, 0x83, 0xe4, 0xca, 0x2c, 0x95, 0x79, 0x62, 0xc7, 0x23, 0xef, 0x63, 0x8b, 0xd8, 0xf1, 0x88,
+	0xdc, 0x85, 0x32, 0x32, 0xb3, 0xd4, 0xbd, 0xd8, 0x16, 0x08, 0x89, 0xfd, 0x2d, 0x10, 0xef, 0x7f,
+	0x0d, 0x66, 0x77, 0x23, 0x83, 0xff, 0x32, 0x29, 0x1e, 0x8f, 0xae, 0xe7, 0x82, 0x16, 0xf7, 0xe3,
+	0xd1, 0x35, 0xdc, 0xef, 0xf7, 0x05, 0xa8, 0xab, 0x37, 0x5c, 0xeb, 0x2b, 0x4f, 0x50, 0x8b, 0x79,
+	0x82, 0x8a, 0xd5, 0x81, 0x27, 0x41, 0x9c, 0x30, 0x45, 0xa2, 0xd5, 0x90, 0xdc, 0xc7, 0x92, 0x38,
+	0x3b, 0x41, 0xb3, 0xb2, 0x58, 0xfb, 0xeb, 0x6a, 0x52, 0x07, 0x42, 0x4c, 0x15, 0xec, 0x75, 0x00,
+	0xb4, 0xf8, 0x86, 0xab, 0x31, 0x9b, 0x54, 0xc9, 0x9c, 0xd4, 0x73, 0x68, 0x1a, 0x5f, 0xf1, 0x9a,
+	0xc3, 0xc0, 0x6d, 0xa8, 0x46, 0x2c, 0x9e, 0x86, 0x13, 0x75, 0xda, 0x92, 0x23, 0xf2, 0x10, 0x6a,
+	0xb8, 0x6e, 0xa6, 0x8c, 0xbb, 0xa5, 0xbb, 0xa5, 0x6b, 0x17, 0x97, 0x52, 0xf2, 0x4e, 0xc0, 0xc9,
+	0x83, 0x18, 0x6a, 0x1c, 0xcc, 0x9f, 0xa7, 0x4c, 0x5e, 0x3c, 0xab, 0x09, 0x15, 0x17, 0x27, 0x54,
+	0xb2, 0x27, 0x24, 0xcf, 0x38, 0x65, 0xf3, 0x8c, 0xb3, 0x07, 0x55, 0x59, 0x2d, 0x6e, 0x98, 0x96,
+	0xdb, 0x50, 0x0d, 0xe7, 0x58, 0xf5, 0xd4, 0x91, 0x46, 0x8e, 0xbc, 0xef, 0x8a, 0x50, 0x95, 0xf7,
+	0x7f, 0xe4, 0x23, 0x80, 0xf1, 0x79, 0x90, 0xc8, 0x51, 0xba, 0xde, 0xd6, 0x55, 0xdd, 0xce, 0xee,
+	0x08, 0x0d, 0x1d, 0xf2, 0x04, 0xb6, 0x26, 0xd3, 0x98, 0x8d, 0x93, 0xf4, 0x62, 0x32, 0x35, 0x95,
+	0x04, 0xfe, 0x0d, 0x41, 0x09, 0x17, 0xe1, 0xce, 0x0a, 0x5d, 0x66, 0x25, 0x88, 0xa1, 0x58, 0x76,
+	0xd1, 0xa5, 0x8a, 0xa1, 0x64, 0x10, 0x43, 0x1b, 0x12, 0xc4, 0xd0, 0x16, 0x91, 0x0f, 0xa1, 0x11,
+	0x8c, 0x9f, 0xa7, 0xa6, 0x92, 0xda, 0xaf, 0x49, 0x62, 0xfb, 0x3c, 0x33, 0xd2, 0x1a, 0x3b, 0x75,
+	0x75, 0x15, 0xea, 0xbd, 0x0d, 0x8d, 0x4c, 0x07, 0xd3, 0xc9, 0xd3, 0xbb, 0xd5, 0x32, 0xc5, 0x47,
+	0xef, 0x47, 0x00, 0x3a, 0x03, 0xaf, 0x69, 0xe8, 0x46, 0x3f, 0x2c, 0xda, 0xed, 0xf3, 0x4b, 0xd8,
+	0xc8, 0xc5, 0x4f, 0x6e, 0x41, 0x15, 0xdb, 0xcb, 0xe8, 0x55, 0xba, 0x22, 0x2a, 0x38, 0xfa, 0x3a,
+	0x13, 0x5f, 0xa9, 0x03, 0x29, 0x8e, 0x9e, 0xa9, 0xa0, 0x30, 0x1f, 0x6b, 0x32, 0xa8, 0x16, 0x6c,
+	0x2d, 0xc9, 0xed, 0x92, 0xc5, 0x60, 0xc4, 0x5b, 0xb4, 0xe2, 0xf5, 0xfe, 0x50, 0x81, 0x32, 0xb6,
+	0x63, 0xf2, 0x00, 0xea, 0xe3, 0xb4, 0x35, 0x9b, 0x65, 0x46, 0xb5, 0xeb, 0xce, 0x0a, 0xcd, 0x70,
+	0x5c, 0x24, 0xfc, 0x8a, 0x27, 0x6c, 0x26, 0xb4, 0x8b, 0x7a, 0x91, 0x0c, 0x33, 0x29, 0x2e, 0x12,
+	0xad, 0x83, 0x74, 0xf5, 0x54, 0xdd, 0x10, 0x60, 0x57, 0x13, 0x86, 0x25, 0x4d, 0x57, 0xf7, 0xf2,
+	0x20, 0xd2, 0xd5, 0x05, 0x0b, 0xe4, 0xdd, 0x52, 0x28, 0x9b, 0xfe, 0xa1, 0xbe, 0x9f, 0xde, 0xd6,
+	0x5e, 0x34, 0x86, 0xbc, 0x3b, 0xaf, 0x4f, 0x3a, 0x40, 0xa4, 0xec, 0x30, 0x66, 0x9c, 0xcd, 0xc7,
+	0x4c, 0x78, 0x91, 0xc7, 0xb8, 0xdb, 0xda, 0x8b, 0x89, 0x76, 0x56, 0xe8, 0x12, 0x1b, 0x9c, 0x94,
+	0xb5, 0x86, 0x85, 0x23, 0xe3, 0x30, 0xd7, 0xce, 0x83, 0x38, 0xa9, 0x05, 0x0b, 0xe4, 0xd2, 0x33,
+	0x6c, 0x15, 0x7b, 0x78, 0xbd, 0x20, 0x7c, 0xd4, 0x34, 0x97, 0x3e, 0xb0, 0x10, 0xe4, 0xd2, 0xb6,
+	0x2e, 0x5a, 0xe3, 0x1e, 0x48, 0x3b, 0x3d, 0x5a, 0xd7, 0xb5, 0x35, 0xb5, 0x10, 0xb4, 0xb6, 0x75,
+	0x31, 0xa1, 0x28, 0x19, 0xce, 0x83, 0x88, 0x9f, 0x87, 0x32, 0xa1, 0xc6, 0x61, 0x8e, 0xe6, 0x30,
+	0x4c, 0x68, 0x5e, 0x1f, 0x29, 0x39, 0x67, 0x9c, 0x4f, 0xc3, 0xb9, 0x30, 0x07, 0xdd, 0x33, 0x87,
+	0x5a, 0x8c, 0x94, 0xdc, 0xd0, 0x52, 0x8b, 0x79, 0x23, 0xdb, 0x61, 0x3b, 0x55, 0xf9, 0x7b, 0x83,
+	0x68, 0x3d, 0x6a, 0xd5, 0xfd, 0x3b, 0x1b, 0x8d, 0xac, 0x43, 0x71, 0x3a, 0x49, 0xab, 0x67, 0x71,
+	0x9a, 0xd5, 0xc6, 0xf2, 0x62, 0x6d, 0xac, 0x18, 0xb5, 0x91, 0x40, 0x39, 0x99, 0xce, 0x98, 0xf8,
+	0x7e, 0x25, 0x2a, 0x9e, 0xbd, 0xdf, 0x15, 0x60, 0x73, 0xe1, 0x23, 0xa6, 0xfe, 0x0b, 0x79, 0xff,
+	0xff, 0xa2, 0x82, 0xaf, 0x43, 0x31, 0x09, 0xd3, 0x20, 0x8a, 0x49, 0x68, 0xce, 0xac, 0x62, 0xcf,
+	0x6c, 0x59, 0x24, 0x73, 0x58, 0xb7, 0x57, 0x02, 0x79, 0x13, 0xea, 0x62, 0x25, 0xe8, 0xae, 0x5c,
+	0x13, 0xe3, 0xee, 0xc4, 0xb8, 0x91, 0x2a, 0x5a, 0x37, 0x52, 0xff, 0x0d, 0x35, 0xd5, 0xaa, 0x65,
+	0x0b, 0xdb, 0xc8, 0x56, 0x98, 0x6c, 0xd8, 0x54, 0xe1, 0xde, 0x13, 0x68, 0x1a, 0xf2, 0x9b, 0xb7,
+	0x97, 0x94, 0xa7, 0x95, 0x2c, 0x9e, 0xf6, 0x08, 0xd6, 0xed, 0x85, 0x78, 0x03, 0x3e, 0xf3, 0x97,
+	0x02, 0x38, 0xf9, 0xd5, 0x77, 0x3d, 0x11, 0x11, 0x29, 0x1b, 0x3f, 0x17, 0xd1, 0x94, 0xa9, 0x78,
+	0x46, 0xd9, 0xe9, 0xe5, 0xc5, 0x45, 0xda, 0xea, 0xc4, 0x33, 0xf9, 0x50, 0x67, 0x40, 0xb2, 0x8e,
+	0xac, 0xad, 0xc8, 0x89, 0x0e, 0x13, 0x64, 0x69, 0x4a, 0x07, 0xbf, 0x91, 0xbc, 0x78, 0x98, 0xb8,
+	0x95, 0xbb, 0x25, 0x4c, 0x71, 0x3a, 0xf4, 0x0e, 0xa0, 0x69, 0x2c, 0x6e, 0xa9, 0xc8, 0x2f, 0x67,
+	0x6c, 0x92, 0xd2, 0x28, 0x35, 0xc4, 0x28, 0x2e, 0x42, 0x2e, 0xaf, 0x16, 0xeb, 0x54, 0x3c, 0x9b,
+	0x85, 0x3c, 0xed, 0x2e, 0x69, 0x6f, 0x30, 0x82, 0x58, 0x92, 0xf2, 0x55, 0x28, 0xbc, 0x12, 0x7e,
+	0x8a, 0xb4, 0xf0, 0x0a, 0x47, 0xb2, 0x37, 0x16, 0x69, 0x21, 0x6b, 0x58, 0x65, 0xdd, 0x1b, 0x3e,
+	0x81, 0xcd, 0x85, 0xa2, 0x8a, 0xbf, 0xe9, 0x9d, 0xc6, 0x59, 0xde, 0xcd, 0xe3, 0x86, 0x90, 0x7b,
+	0x9f, 0x81, 0x93, 0xaf, 0xa1, 0x37, 0x3a, 0x4f, 0xfd, 0x3f, 0x90, 0xc5, 0xaa, 0x79, 0x23, 0xcb,
+	0x1f, 0x00, 0xe8, 0xa6, 0x21, 0xbe, 0xe3, 0x55, 0xa4, 0xf6, 0xba, 0x78, 0x7e, 0x4d, 0xef, 0x7a,
+	0x07, 0xaa, 0xc3, 0x24, 0x9e, 0xce, 0xcf, 0x90, 0x1e, 0xbd, 0x0c, 0x2e, 0x2e, 0x95, 0xa1, 0x1c,
+	0x78, 0x7f, 0x2c, 0x40, 0x19, 0x0f, 0xc6, 0x0b, 0x3b, 0x76, 0x1b, 0x2a, 0x6c, 0x16, 0x4c, 0x55,
+	0xe5, 0x90, 0x03, 0xbc, 0xb0, 0x95, 0x77, 0x0d, 0x93, 0x51, 0x90, 0xa4, 0x7b, 0xb7, 0x91, 0x4a,
+	0x5a, 0x09, 0xc2, 0xf2, 0x0e, 0x47, 0xc0, 0x72, 0x23, 0x37, 0x52, 0x49, 0x2b, 0x31, 0x4f, 0x56,
+	0x95, 0xd7, 0x9c, 0xac, 0x6a, 0x50, 0xf1, 0x67, 0x51, 0x72, 0xe5, 0x7d, 0x93, 0x3b, 0x9d, 0xb5,
+	0xe2, 0xb3, 0x25, 0xdf, 0xdc, 0x3c, 0x56, 0x15, 0xaf, 0x3d, 0x56, 0x95, 0x96, 0x1d, 0xab, 0xca,
+	0xfa, 0xbe, 0xb5, 0x6f, 0x1d, 0x94, 0xf0, 0x4d, 0xcb, 0xb9, 0xef, 0x62, 0x25, 0xcb, 0x78, 0x67,
+	0xc9, 0xe4, 0x9d, 0xa7, 0xb0, 0x6e, 0x70, 0xdb, 0xef, 0xe9, 0x6f, 0xf1, 0x37, 0x0b, 0xe3, 0xaa,
+	0xbd, 0x6c, 0x5d, 0xb5, 0x3f, 0x35, 0x8e, 0x56, 0xcb, 0xf3, 0x73, 0x5d, 0xa9, 0xb3, 0x2f, 0xdf,
+	0x4b, 0xf9, 0xcb, 0x77, 0x1f, 0xd6, 0x70, 0xbd, 0xc9, 0x95, 0xb7, 0xdc, 0xb3, 0x97, 0xfe, 0xfc,
+	0xbd, 0x94, 0xdd, 0xc8, 0x9f, 0xc0, 0x1f, 0xfc, 0xb9, 0x0e, 0x8d, 0xec, 0xba, 0x86, 0x6c, 0x40,
+	0xd3, 0xa7, 0x74, 0x74, 0xdc, 0x7f, 0xd2, 0x1f, 0x3c, 0xed, 0x3b, 0x2b, 0xc4, 0x81, 0x55, 0x14,
+	0x74, 0xfb, 0x47, 0x3e, 0xed, 0xb7, 0x7a, 0x4e, 0x81, 0xb8, 0xb0, 0x2d, 0x25, 0x5f, 0xb5, 0x7a,
+	0xdd, 0xf6, 0xa8, 0x45, 0xf7, 0x8f, 0x0f, 0xfc, 0xfe, 0x91, 0x53, 0x24, 0x9b, 0xb0, 0x86, 0x48,
+	0x7f, 0x70, 0x34, 0xda, 0x1b, 0x1c, 0xf7, 0xdb, 0x4e, 0x89, 0xdc, 0x06, 0x82, 0xa2, 0x56, 0x8f,
+	0xfa, 0xad, 0xf6, 0xb3, 0x91, 0xff, 0x75, 0x77, 0x78, 0x34, 0x74, 0xca, 0xe4, 0x0d, 0xd8, 0x92,
+	0xef, 0x69, 0x1d, 0x1f, 0x75, 0xfc, 0xfe, 0x51, 0x77, 0xb7, 0x75, 0xe4, 0xb7, 0x9d, 0x0a, 0x79,
+	0x13, 0x6e, 0x21, 0x70, 0xe8, 0xd3, 0x83, 0xee, 0x70, 0xd8, 0x1d, 0xf4, 0x47, 0x6d, 0xbf, 0xdf,
+	0xf5, 0xdb, 0x4e, 0x55, 0x41, 0x47, 0x83, 0xc1, 0xe8, 0xa0, 0xd5, 0x7f, 0x36, 0xa2, 0xfe, 0x97,
+	0xc7, 0x3e, 0xba, 0xab, 0xa9, 0xb0, 0x8f, 0xba, 0x07, 0xfe, 0xe0, 0xf8, 0xc8, 0xa9, 0x93, 0x2d,
+	0xd8, 0x48, 0xfd, 0x7f, 0xd5, 0xea, 0xf6, 0x5a, 0x3b, 0x3d, 0xdf, 0x69, 0xe8, 0x97, 0x8a, 0xc9,
+	0x29, 0x7b, 0x07, 0xc8, 0x2d, 0xd8, 0x44, 0xc0, 0x3f, 0x68, 0x75, 0x7b, 0x6a, 0x62, 0xce, 0xc4,
+	0x16, 0x8b, 0xd0, 0xfd, 0xb6, 0xc3, 0x94, 0x1b, 0x29, 0xc6, 0xc9, 0x0a, 0xc8, 0x39, 0x55, 0x99,
+	0x39, 0x6c, 0x0d, 0x87, 0x4f, 0x07, 0xb4, 0x9d, 0x79, 0x3a, 0x53, 0x69, 0xc8, 0x90, 0xa7, 0x74,
+	0xd0, 0xdf, 0x77, 0xce, 0xd5, 0x1b, 0x8e, 0x06, 0x4f, 0xfc, 0xfe, 0x48, 0x4c, 0xb8, 0xbf, 0xef,
+	0x4c, 0x6d, 0xb1, 0xf2, 0xf2, 0x8d, 0xf2, 0x72, 0x3c, 0xf4, 0xcd, 0x24, 0x3f, 0x57, 0x01, 0xed,
+	0x76, 0x5a, 0xfd, 0xbe, 0xaf, 0x27, 0x70, 0x41, 0xee, 0xc0, 0x6d, 0x13, 0x40, 0x9b, 0xc7, 0x83,
+	0x6e, 0xdf, 0x6f, 0x3b, 0x33, 0xe5, 0x6c, 0xf7, 0x98, 0x0e, 0x07, 0xd9, 0xd7, 0x74, 0xe6, 0x2a,
+	0x73, 0x7b, 0xb4, 0xeb, 0xf7, 0xdb, 0xa3, 0xa1, 0xdf, 0xdb, 0x73, 0x42, 0xa5, 0x9c, 0x0a, 0x55,
+	0x2a, 0x22, 0xf2, 0x0e, 0xdc, 0x31, 0xe4, 0x69, 0x42, 0x33, 0xfc, 0x05, 0xb9, 0x0b, 0x6f, 0x2d,
+	0xc1, 0x75, 0xec, 0xb1, 0xca, 0x59, 0xaa, 0xa1, 0x11, 0xae, 0x90, 0x7e, 0x77, 0xf7, 0x49, 0xbf,
+	0x75, 0xe0, 0x67, 0x21, 0x26, 0xd9, 0xa2, 0xfa, 0xaa, 0x75, 0xd4, 0xd2, 0xa1, 0x5f, 0xaa, 0xd0,
+	0x77, 0xba, 0x83, 0x4c, 0xf8, 0x92, 0xbc, 0x0d, 0x6f, 0xa2, 0xb0, 0xe7, 0xb7, 0xda, 0x3e, 0xdd,
+	0x19, 0xb4, 0xa8, 0xf9, 0x96, 0x9f, 0xa9, 0x54, 0x0f, 0x77, 0x07, 0x54, 0xbf, 0xe2, 0x95, 0x9a,
+	0x58, 0xde, 0x8a, 0xb6, 0xfa, 0x4f, 0xfc, 0xb6, 0x73, 0x45, 0xde, 0x02, 0x17, 0xf1, 0x83, 0xd6,
+	0xd1, 0x6e, 0x67, 0x44, 0xfd, 0x7d, 0x5c, 0xa8, 0xca, 0xfa, 0x5b, 0x35, 0x6d, 0x89, 0x1e, 0xb6,
+	0xe8, 0xd1, 0xb3, 0xd1, 0xb0, 0xfb, 0x63, 0xed, 0xff, 0xe7, 0x64, 0x1b, 0x1c, 0xad, 0xf1, 0xe5,
+	0xb1, 0x7f, 0xec, 0xb7, 0x9d, 0x5f, 0xa8, 0x29, 0x4b, 0x29, 0xbe, 0x2f, 0x45, 0x7e, 0xa9, 0xa6,
+	0x4c, 0x07, 0x83, 0x03, 0x23, 0xfc, 0x5f, 0xa9, 0x2d, 0x27, 0xe4, 0x7b, 0xc7, 0xbd, 0x9e, 0xf3,
+	0x6b, 0x72, 0x0b, 0x9c, 0x4c, 0x34, 0x3c, 0x6a, 0x51, 0xfc, 0x12, 0xbf, 0x29, 0x90, 0x6d, 0xd8,
+	0xc8, 0xc4, 0xe9, 0x22, 0xf8, 0x0e, 0x37, 0xf3, 0x96, 0xe5, 0x37, 0x45, 0x7e, 0x5b, 0x78, 0xf4,
+	0x8f, 0x22, 0x34, 0xf7, 0x83, 0x19, 0x1b, 0xb2, 0xf8, 0xe5, 0x74, 0xcc, 0xc8, 0x7b, 0xd0, 0x34,
+	0xae, 0x70, 0x89, 0xe8, 0x83, 0xb2, 0x73, 0xdd, 0xc9, 0x6e, 0x6f, 0xc9, 0xfb, 0x00, 0xfa, 0x9a,
+	0xcf, 0xd2, 0x31, 0x3b, 0x09, 0x79, 0x04, 0x6b, 0x56, 0xdf, 0x20, 0x8b, 0xbf, 0x38, 0xb4, 0xe2,
+	0x9c, 0xcd, 0xff, 0x41, 0xd3, 0xa8, 0xff, 0x24, 0xff, 0x0b, 0x03, 0xea, 0x2f, 0xbd, 0xcd, 0x20,
+	0x9f, 0x8a, 0xbb, 0x67, 0x43, 0x2c, 0x6d, 0xed, 0xe2, 0x7f, 0x27, 0x7f, 0x3f, 0x46, 0xee, 0x43,
+	0x23, 0xab, 0xdb, 0xc4, 0xfe, 0x31, 0x01, 0xf5, 0xe5, 0xaf, 0x7a, 0xd8, 0x05, 0xc9, 0x3d, 0x68,
+	0x1a, 0x97, 0x65, 0xd6, 0xac, 0x0d, 0xad, 0x07, 0x00, 0xba, 0x5c, 0x93, 0x4d, 0xf5, 0x57, 0xa4,
+	0xac, 0x7c, 0x1b, 0xba, 0x27, 0x55, 0xf1, 0x6f, 0xa9, 0x4f, 0xfe, 0x39, 0x00, 0x81, 0xb8, 0x59,
+	0x85, 0x3e, 0x25, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -777,6 +4825,13 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type GameServiceClient interface {
 	GetUserInfo(ctx context.Context, in *String, opts ...grpc.CallOption) (*User, error)
+	GetProfile(ctx context.Context, in *String, opts ...grpc.CallOption) (*Profile, error)
+	UpdateProfile(ctx context.Context, in *UpdateProfileArg, opts ...grpc.CallOption) (*Profile, error)
+	SubmitScore(ctx context.Context, in *SubmitScoreArg, opts ...grpc.CallOption) (*LeaderboardEntry, error)
+	GetLeaderboard(ctx context.Context, in *LeaderboardArg, opts ...grpc.CallOption) (*Leaderboard, error)
+	JoinMatch(ctx context.Context, in *JoinMatchArg, opts ...grpc.CallOption) (*Empty, error)
+	CancelMatch(ctx context.Context, in *String, opts ...grpc.CallOption) (*Empty, error)
+	PushSystem(ctx context.Context, in *PushSystemArg, opts ...grpc.CallOption) (*Empty, error)
 }
 
 type gameServiceClient struct {
@@ -796,9 +4851,79 @@ func (c *gameServiceClient) GetUserInfo(ctx context.Context, in *String, opts ..
 	return out, nil
 }
 
+func (c *gameServiceClient) GetProfile(ctx context.Context, in *String, opts ...grpc.CallOption) (*Profile, error) {
+	out := new(Profile)
+	err := c.cc.Invoke(ctx, "/pb.GameService/GetProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) UpdateProfile(ctx context.Context, in *UpdateProfileArg, opts ...grpc.CallOption) (*Profile, error) {
+	out := new(Profile)
+	err := c.cc.Invoke(ctx, "/pb.GameService/UpdateProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) SubmitScore(ctx context.Context, in *SubmitScoreArg, opts ...grpc.CallOption) (*LeaderboardEntry, error) {
+	out := new(LeaderboardEntry)
+	err := c.cc.Invoke(ctx, "/pb.GameService/SubmitScore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) GetLeaderboard(ctx context.Context, in *LeaderboardArg, opts ...grpc.CallOption) (*Leaderboard, error) {
+	out := new(Leaderboard)
+	err := c.cc.Invoke(ctx, "/pb.GameService/GetLeaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) JoinMatch(ctx context.Context, in *JoinMatchArg, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/pb.GameService/JoinMatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) CancelMatch(ctx context.Context, in *String, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/pb.GameService/CancelMatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) PushSystem(ctx context.Context, in *PushSystemArg, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/pb.GameService/PushSystem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GameServiceServer is the server API for GameService service.
 type GameServiceServer interface {
 	GetUserInfo(context.Context, *String) (*User, error)
+	GetProfile(context.Context, *String) (*Profile, error)
+	UpdateProfile(context.Context, *UpdateProfileArg) (*Profile, error)
+	SubmitScore(context.Context, *SubmitScoreArg) (*LeaderboardEntry, error)
+	GetLeaderboard(context.Context, *LeaderboardArg) (*Leaderboard, error)
+	JoinMatch(context.Context, *JoinMatchArg) (*Empty, error)
+	CancelMatch(context.Context, *String) (*Empty, error)
+	PushSystem(context.Context, *PushSystemArg) (*Empty, error)
 }
 
 // UnimplementedGameServiceServer can be embedded to have forward compatible implementations.
@@ -808,6 +4933,27 @@ type UnimplementedGameServiceServer struct {
 func (*UnimplementedGameServiceServer) GetUserInfo(ctx context.Context, req *String) (*User, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserInfo not implemented")
 }
+func (*UnimplementedGameServiceServer) GetProfile(ctx context.Context, req *String) (*Profile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfile not implemented")
+}
+func (*UnimplementedGameServiceServer) UpdateProfile(ctx context.Context, req *UpdateProfileArg) (*Profile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
+}
+func (*UnimplementedGameServiceServer) SubmitScore(ctx context.Context, req *SubmitScoreArg) (*LeaderboardEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitScore not implemented")
+}
+func (*UnimplementedGameServiceServer) GetLeaderboard(ctx context.Context, req *LeaderboardArg) (*Leaderboard, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeaderboard not implemented")
+}
+func (*UnimplementedGameServiceServer) JoinMatch(ctx context.Context, req *JoinMatchArg) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinMatch not implemented")
+}
+func (*UnimplementedGameServiceServer) CancelMatch(ctx context.Context, req *String) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelMatch not implemented")
+}
+func (*UnimplementedGameServiceServer) PushSystem(ctx context.Context, req *PushSystemArg) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushSystem not implemented")
+}
 
 func RegisterGameServiceServer(s *grpc.Server, srv GameServiceServer) {
 	s.RegisterService(&_GameService_serviceDesc, srv)
@@ -831,6 +4977,132 @@ func _GameService_GetUserInfo_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GameService_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(String)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).GetProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.GameService/GetProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).GetProfile(ctx, req.(*String))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileArg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).UpdateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.GameService/UpdateProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).UpdateProfile(ctx, req.(*UpdateProfileArg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_SubmitScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitScoreArg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).SubmitScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.GameService/SubmitScore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).SubmitScore(ctx, req.(*SubmitScoreArg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_GetLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaderboardArg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).GetLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.GameService/GetLeaderboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).GetLeaderboard(ctx, req.(*LeaderboardArg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_JoinMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinMatchArg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).JoinMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.GameService/JoinMatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).JoinMatch(ctx, req.(*JoinMatchArg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_CancelMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(String)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).CancelMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.GameService/CancelMatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).CancelMatch(ctx, req.(*String))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_PushSystem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushSystemArg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).PushSystem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.GameService/PushSystem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).PushSystem(ctx, req.(*PushSystemArg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _GameService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pb.GameService",
 	HandlerType: (*GameServiceServer)(nil),
@@ -839,6 +5111,34 @@ var _GameService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserInfo",
 			Handler:    _GameService_GetUserInfo_Handler,
 		},
+		{
+			MethodName: "GetProfile",
+			Handler:    _GameService_GetProfile_Handler,
+		},
+		{
+			MethodName: "UpdateProfile",
+			Handler:    _GameService_UpdateProfile_Handler,
+		},
+		{
+			MethodName: "SubmitScore",
+			Handler:    _GameService_SubmitScore_Handler,
+		},
+		{
+			MethodName: "GetLeaderboard",
+			Handler:    _GameService_GetLeaderboard_Handler,
+		},
+		{
+			MethodName: "JoinMatch",
+			Handler:    _GameService_JoinMatch_Handler,
+		},
+		{
+			MethodName: "CancelMatch",
+			Handler:    _GameService_CancelMatch_Handler,
+		},
+		{
+			MethodName: "PushSystem",
+			Handler:    _GameService_PushSystem_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "message.proto",
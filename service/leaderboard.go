@@ -0,0 +1,191 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"game_server/common"
+	"game_server/model"
+	"game_server/pb"
+	"log"
+	"time"
+)
+
+const (
+	defaultLeaderboardLimit = 10
+	maxLeaderboardLimit     = 100
+
+	archiveCheckInterval = time.Minute
+)
+
+func findBoard(name string) (common.BoardConfig, bool) {
+	for _, board := range common.GetConfig().Leaderboard.Boards {
+		if board.Name == name {
+			return board, true
+		}
+	}
+	return common.BoardConfig{}, false
+}
+
+// Period id at utc, eg: daily 2006-01-02, weekly 2006-W01, empty as never reset
+func periodId(period string, t time.Time) string {
+	t = t.UTC()
+	switch period {
+	case "daily":
+		return t.Format("2006-01-02")
+	case "weekly":
+		year, week := t.ISOWeek()
+		return fmt.Sprintf("%d-W%02d", year, week)
+	}
+	return ""
+}
+
+func previousPeriodId(period string, t time.Time) string {
+	switch period {
+	case "daily":
+		return periodId(period, t.AddDate(0, 0, -1))
+	case "weekly":
+		return periodId(period, t.AddDate(0, 0, -7))
+	}
+	return ""
+}
+
+// Keep period key after reset, until archived
+func periodExpire(period string) time.Duration {
+	switch period {
+	case "daily":
+		return 3 * 24 * time.Hour
+	case "weekly":
+		return 15 * 24 * time.Hour
+	}
+	return 0
+}
+
+func (s *GameServiceServer) SubmitScore(ctx context.Context, arg *pb.SubmitScoreArg) (*pb.LeaderboardEntry, error) {
+	board, ok := findBoard(arg.GetBoard())
+	if !ok {
+		return nil, pb.NewError(pb.ErrorCode_ERR_LEADERBOARD_NOT_FOUND, "leaderboard not found")
+	}
+	// score is from client, check bound as no server side result yet
+	if arg.GetScore() < 0 || (board.MaxScore > 0 && arg.GetScore() > board.MaxScore) {
+		return nil, pb.NewError(pb.ErrorCode_ERR_SCORE_INVALID, "score invalid")
+	}
+	if interval := common.GetConfig().Leaderboard.SubmitInterval; interval > 0 {
+		ok, err := model.AllowScoreSubmit(board.Name, arg.GetUid(), interval)
+		if err != nil {
+			log.Println(err)
+			return nil, pb.NewError(pb.ErrorCode_ERR_INTERNAL, "server error")
+		}
+		if !ok {
+			return nil, pb.NewError(pb.ErrorCode_ERR_TOO_MANY_REQUESTS, "submit too often")
+		}
+	}
+
+	period := periodId(board.Period, time.Now())
+	entry, err := model.SubmitScore(board.Name, period, arg.GetUid(), arg.GetScore(), periodExpire(board.Period))
+	if err != nil {
+		log.Println(err)
+		return nil, pb.NewError(pb.ErrorCode_ERR_INTERNAL, "server error")
+	}
+
+	rst := leaderboardEntry(entry)
+	fillLeaderboardNames([]*pb.LeaderboardEntry{rst})
+	return rst, nil
+}
+
+// Top entries, or entries around uid
+func (s *GameServiceServer) GetLeaderboard(ctx context.Context, arg *pb.LeaderboardArg) (*pb.Leaderboard, error) {
+	board, ok := findBoard(arg.GetBoard())
+	if !ok {
+		return nil, pb.NewError(pb.ErrorCode_ERR_LEADERBOARD_NOT_FOUND, "leaderboard not found")
+	}
+
+	limit := int(arg.GetLimit())
+	if limit <= 0 {
+		limit = defaultLeaderboardLimit
+	} else if limit > maxLeaderboardLimit {
+		limit = maxLeaderboardLimit
+	}
+
+	period := periodId(board.Period, time.Now())
+	var entries []*model.LeaderboardEntry
+	var err error
+	if arg.GetAround() {
+		entries, err = model.GetLeaderboardAround(board.Name, period, arg.GetUid(), limit)
+	} else {
+		entries, err = model.GetLeaderboardTop(board.Name, period, limit)
+	}
+	if err == model.ErrNotFound {
+		return nil, pb.NewError(pb.ErrorCode_ERR_LEADERBOARD_NOT_RANKED, "no score at leaderboard")
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, pb.NewError(pb.ErrorCode_ERR_INTERNAL, "server error")
+	}
+
+	rst := &pb.Leaderboard{Board: board.Name, Period: period}
+	for _, entry := range entries {
+		rst.Entries = append(rst.Entries, leaderboardEntry(entry))
+	}
+	fillLeaderboardNames(rst.Entries)
+	return rst, nil
+}
+
+// Entry without name, see fillLeaderboardNames
+func leaderboardEntry(entry *model.LeaderboardEntry) *pb.LeaderboardEntry {
+	return &pb.LeaderboardEntry{
+		Rank:  int32(entry.Rank),
+		Uid:   entry.Uid,
+		Score: entry.Score,
+	}
+}
+
+// Display names of page from mgo in one query, name changed by online user
+// is shown after flush, name empty if user lookup failed
+func fillLeaderboardNames(entries []*pb.LeaderboardEntry) {
+	uids := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		uids = append(uids, entry.GetUid())
+	}
+	users, err := model.FindUsersByIds(uids)
+	if err != nil {
+		log.Println("leaderboard users lookup failed, err:", err)
+		return
+	}
+	for _, entry := range entries {
+		if usr, ok := users[entry.GetUid()]; ok {
+			entry.Name = usr.GetName()
+		}
+	}
+}
+
+// Archive previous period of periodic boards until stop,
+// archived by other service is skipped by unique index
+func runLeaderboardArchiver(stop <-chan struct{}) {
+	archived := make(map[string]string) // board name to last archived period
+
+	ticker := time.NewTicker(archiveCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		cfg := common.GetConfig().Leaderboard
+		for _, board := range cfg.Boards {
+			period := previousPeriodId(board.Period, time.Now())
+			if period == "" || archived[board.Name] == period {
+				continue
+			}
+
+			err := model.ArchiveLeaderboard(board.Name, period, cfg.ArchiveSize)
+			if err != nil && err != model.ErrDuplicate {
+				log.Println("archive leaderboard failed, board:", board.Name, "period:", period, "err:", err)
+				continue
+			}
+			archived[board.Name] = period
+		}
+
+		select {
+		case <-ticker.C:
+		case <-stop:
+			return
+		}
+	}
+}
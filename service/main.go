@@ -1,20 +1,49 @@
 package main
 
 import (
+	"flag"
+	"game_server/common"
+	"game_server/model"
 	"game_server/pb"
 	"log"
 	"net"
+	"time"
 
 	"google.golang.org/grpc"
 )
 
 func main() {
+	flag.Parse()
+	model.InitStores(common.GetConfig().Storage)
+
 	grpcServer := grpc.NewServer()
 	pb.RegisterGameServiceServer(grpcServer, new(GameServiceServer))
 
-	lis, err := net.Listen("tcp", ":1234")
+	lis, err := net.Listen("tcp", common.GetConfig().Service.Addr)
 	if err != nil {
 		log.Fatal(err)
 	}
-	grpcServer.Serve(lis)
+	go func() {
+		if err := grpcServer.Serve(lis); err != nil {
+			log.Fatal(err)
+		}
+	}()
+
+	stopArchiver := make(chan struct{})
+	go runLeaderboardArchiver(stopArchiver)
+
+	// Drain running calls, force stop after timeout
+	common.WaitSignal()
+	close(stopArchiver)
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(common.GetConfig().Service.ShutdownTimeout):
+		log.Println("service graceful stop timeout, force stop")
+		grpcServer.Stop()
+	}
 }
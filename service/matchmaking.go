@@ -0,0 +1,267 @@
+package main
+
+import (
+	"context"
+	"game_server/common"
+	"game_server/model"
+	"game_server/pb"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+)
+
+var defaultMatchmaker *Matchmaker
+var defaultMatchmakerOnce sync.Once
+
+// Queues are in service memory, only one service instance should matchmake,
+// queued users are lost when service restart
+type Matchmaker struct {
+	mu     sync.Mutex
+	queues map[matchQueueKey][]*matchTicket // in enqueue order
+	queued map[string]matchQueueKey         // use uid as key
+}
+
+// Only users at same region and party size are matched
+type matchQueueKey struct {
+	region    string
+	partySize int
+}
+
+type matchTicket struct {
+	uid        string
+	name       string
+	rating     int
+	enqueuedAt time.Time
+}
+
+func GetMatchmaker() *Matchmaker {
+	defaultMatchmakerOnce.Do(func() {
+		defaultMatchmaker = newMatchmaker()
+		go defaultMatchmaker.run()
+	})
+	return defaultMatchmaker
+}
+
+func newMatchmaker() *Matchmaker {
+	return &Matchmaker{
+		queues: make(map[matchQueueKey][]*matchTicket),
+		queued: make(map[string]matchQueueKey),
+	}
+}
+
+func (m *Matchmaker) Join(key matchQueueKey, ticket *matchTicket) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.queued[ticket.uid]; ok {
+		return pb.NewError(pb.ErrorCode_ERR_MATCH_QUEUED, "already in queue")
+	}
+	m.queues[key] = append(m.queues[key], ticket)
+	m.queued[ticket.uid] = key
+	return nil
+}
+
+func (m *Matchmaker) Cancel(uid string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key, ok := m.queued[uid]
+	if !ok {
+		return pb.NewError(pb.ErrorCode_ERR_MATCH_NOT_QUEUED, "not in queue")
+	}
+	delete(m.queued, uid)
+
+	tickets := m.queues[key]
+	for i, ticket := range tickets {
+		if ticket.uid == uid {
+			tickets = append(tickets[:i], tickets[i+1:]...)
+			break
+		}
+	}
+	if len(tickets) == 0 {
+		delete(m.queues, key)
+	} else {
+		m.queues[key] = tickets
+	}
+	return nil
+}
+
+func (m *Matchmaker) run() {
+	ticker := time.NewTicker(common.GetConfig().Matchmaking.Interval)
+	defer ticker.Stop()
+
+	for now := range ticker.C {
+		for _, push := range m.match(now) {
+			msg := pb.MakePush_MatchFoundPush(push)
+			for _, player := range push.GetPlayers() {
+				if err := PushUser(player.GetUid(), msg); err != nil {
+					log.Println("push match found failed, uid:", player.GetUid(), "err:", err)
+				}
+			}
+		}
+	}
+}
+
+// Form matches of all queues, matched users are dequeued
+func (m *Matchmaker) match(now time.Time) []*pb.MatchFoundPush {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	pushes := []*pb.MatchFoundPush{}
+	for key, tickets := range m.queues {
+		groups, rest := matchQueue(key.partySize, tickets, now)
+		for _, group := range groups {
+			push := &pb.MatchFoundPush{
+				MatchId: bson.NewObjectId().Hex(),
+				Region:  key.region,
+			}
+			for _, ticket := range group {
+				delete(m.queued, ticket.uid)
+				push.Players = append(push.Players, &pb.MatchPlayer{
+					Uid:    ticket.uid,
+					Name:   ticket.name,
+					Rating: int32(ticket.rating),
+				})
+			}
+			pushes = append(pushes, push)
+		}
+
+		if len(rest) == 0 {
+			delete(m.queues, key)
+		} else {
+			m.queues[key] = rest
+		}
+	}
+	return pushes
+}
+
+// Oldest ticket first, take nearest ratings within its tolerance of all
+// group members, so ratings of one match differ at most tolerance,
+// tolerance widen by waited time so long waiting one get matched
+func matchQueue(partySize int, tickets []*matchTicket, now time.Time) ([][]*matchTicket, []*matchTicket) {
+	groups := [][]*matchTicket{}
+	used := make(map[*matchTicket]bool)
+
+	for _, anchor := range tickets {
+		if used[anchor] {
+			continue
+		}
+
+		tolerance := matchTolerance(anchor, now)
+		candidates := []*matchTicket{}
+		for _, ticket := range tickets {
+			if ticket != anchor && !used[ticket] && ratingDiff(anchor, ticket) <= tolerance {
+				candidates = append(candidates, ticket)
+			}
+		}
+		if len(candidates) < partySize-1 {
+			continue
+		}
+
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return ratingDiff(anchor, candidates[i]) < ratingDiff(anchor, candidates[j])
+		})
+		group := []*matchTicket{anchor}
+		for _, ticket := range candidates {
+			if len(group) == partySize {
+				break
+			}
+			if withinTolerance(group, ticket, tolerance) {
+				group = append(group, ticket)
+			}
+		}
+		if len(group) < partySize {
+			continue
+		}
+		for _, ticket := range group {
+			used[ticket] = true
+		}
+		groups = append(groups, group)
+	}
+
+	rest := []*matchTicket{}
+	for _, ticket := range tickets {
+		if !used[ticket] {
+			rest = append(rest, ticket)
+		}
+	}
+	return groups, rest
+}
+
+func matchTolerance(ticket *matchTicket, now time.Time) int {
+	cfg := common.GetConfig().Matchmaking
+	tolerance := cfg.BaseTolerance + cfg.WidenRate*int(now.Sub(ticket.enqueuedAt).Seconds())
+	if tolerance > cfg.MaxTolerance {
+		tolerance = cfg.MaxTolerance
+	}
+	return tolerance
+}
+
+func withinTolerance(group []*matchTicket, ticket *matchTicket, tolerance int) bool {
+	for _, member := range group {
+		if ratingDiff(member, ticket) > tolerance {
+			return false
+		}
+	}
+	return true
+}
+
+func ratingDiff(a, b *matchTicket) int {
+	if a.rating > b.rating {
+		return a.rating - b.rating
+	}
+	return b.rating - a.rating
+}
+
+// Enqueue user with rating from user record
+func (s *GameServiceServer) JoinMatch(ctx context.Context, arg *pb.JoinMatchArg) (*pb.Empty, error) {
+	cfg := common.GetConfig().Matchmaking
+
+	validRegion := false
+	for _, region := range cfg.Regions {
+		if region == arg.GetRegion() {
+			validRegion = true
+			break
+		}
+	}
+	if !validRegion {
+		return nil, pb.NewError(pb.ErrorCode_ERR_MATCH_REGION_INVALID, "region invalid")
+	}
+
+	partySize := int(arg.GetPartySize())
+	if partySize < 2 || partySize > cfg.MaxPartySize {
+		return nil, pb.NewError(pb.ErrorCode_ERR_MATCH_PARTY_SIZE_INVALID, "party size invalid")
+	}
+
+	usr, err := model.GetUserById(arg.GetUid())
+	if err == model.ErrNotFound {
+		return nil, pb.NewError(pb.ErrorCode_ERR_USER_NOT_FOUND, "user not found")
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, pb.NewError(pb.ErrorCode_ERR_INTERNAL, "server error")
+	}
+
+	key := matchQueueKey{region: arg.GetRegion(), partySize: partySize}
+	err = GetMatchmaker().Join(key, &matchTicket{
+		uid:        usr.GetId(),
+		name:       usr.GetName(),
+		rating:     usr.GetRating(),
+		enqueuedAt: time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (s *GameServiceServer) CancelMatch(ctx context.Context, arg *pb.String) (*pb.Empty, error) {
+	if err := GetMatchmaker().Cancel(arg.GetValue()); err != nil {
+		return nil, err
+	}
+	return &pb.Empty{}, nil
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"game_server/common"
+	"testing"
+	"time"
+)
+
+func TestMatchQueue(t *testing.T) {
+	cfg := &common.GetConfig().Matchmaking
+	cfg.BaseTolerance, cfg.WidenRate, cfg.MaxTolerance = 50, 10, 500
+
+	now := time.Now()
+	ticket := func(uid string, rating int, waited time.Duration) *matchTicket {
+		return &matchTicket{uid: uid, rating: rating, enqueuedAt: now.Add(-waited)}
+	}
+
+	tests := []struct {
+		name      string
+		partySize int
+		tickets   []*matchTicket
+		groups    [][]string
+		rest      []string
+	}{
+		{
+			name:      "within base tolerance",
+			partySize: 2,
+			tickets:   []*matchTicket{ticket("a", 1000, 0), ticket("b", 1040, 0), ticket("c", 1500, 0)},
+			groups:    [][]string{{"a", "b"}},
+			rest:      []string{"c"},
+		},
+		{
+			name:      "out of base tolerance",
+			partySize: 2,
+			tickets:   []*matchTicket{ticket("a", 1000, 0), ticket("b", 1100, 0)},
+			rest:      []string{"a", "b"},
+		},
+		{
+			name:      "widened by wait",
+			partySize: 2,
+			tickets:   []*matchTicket{ticket("a", 1000, 6*time.Second), ticket("b", 1100, 0)},
+			groups:    [][]string{{"a", "b"}},
+		},
+		{
+			name:      "widen capped",
+			partySize: 2,
+			tickets:   []*matchTicket{ticket("a", 1000, time.Hour), ticket("b", 1600, 0)},
+			rest:      []string{"a", "b"},
+		},
+		{
+			name:      "nearest taken",
+			partySize: 2,
+			tickets:   []*matchTicket{ticket("a", 1000, 0), ticket("b", 1040, 0), ticket("c", 1010, 0)},
+			groups:    [][]string{{"a", "c"}},
+			rest:      []string{"b"},
+		},
+		{
+			// both in tolerance of a at each side, but not of each other
+			name:      "within tolerance of whole group",
+			partySize: 3,
+			tickets:   []*matchTicket{ticket("a", 1000, 0), ticket("b", 960, 0), ticket("c", 1040, 0)},
+			rest:      []string{"a", "b", "c"},
+		},
+		{
+			name:      "leftover not enough for party",
+			partySize: 3,
+			tickets:   []*matchTicket{ticket("a", 1000, 0), ticket("b", 1010, 0), ticket("c", 1020, 0), ticket("d", 1030, 0)},
+			groups:    [][]string{{"a", "b", "c"}},
+			rest:      []string{"d"},
+		},
+	}
+
+	for _, tt := range tests {
+		groups, rest := matchQueue(tt.partySize, tt.tickets, now)
+		if len(groups) != len(tt.groups) {
+			t.Errorf("%s: groups = %d, want %d", tt.name, len(groups), len(tt.groups))
+			continue
+		}
+		for i, group := range groups {
+			if uids := ticketUids(group); !equalUids(uids, tt.groups[i]) {
+				t.Errorf("%s: group %d = %v, want %v", tt.name, i, uids, tt.groups[i])
+			}
+		}
+		if uids := ticketUids(rest); !equalUids(uids, tt.rest) {
+			t.Errorf("%s: rest = %v, want %v", tt.name, uids, tt.rest)
+		}
+	}
+}
+
+func ticketUids(tickets []*matchTicket) []string {
+	uids := []string{}
+	for _, ticket := range tickets {
+		uids = append(uids, ticket.uid)
+	}
+	return uids
+}
+
+func equalUids(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"game_server/common"
+	"game_server/pb"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Service push message to user through gateways, user offline will miss it
+
+// Push message to user client, whichever gateway it connected
+func PushUser(uid string, msg *pb.Message) error {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return common.PublishCluster(common.PushChannel, &common.ClusterMessage{Uid: uid, Data: data})
+}
+
+// Push message to all user clients
+func PushAll(msg *pb.Message) error {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return common.PublishCluster(common.BroadcastChannel, &common.ClusterMessage{Data: data})
+}
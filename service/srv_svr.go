@@ -2,19 +2,23 @@ package main
 
 import (
 	"context"
-	"errors"
 	"game_server/model"
 	"game_server/pb"
+	"log"
+	"strings"
 )
 
 type GameServiceServer struct{}
 
 func (s *GameServiceServer) GetUserInfo(ctx context.Context, arg *pb.String) (*pb.User, error) {
 	uid := arg.GetValue()
-	usr := model.GetUserById(uid)
-
-	if usr == nil {
-		return nil, errors.New("user not found")
+	usr, err := model.GetUserById(uid)
+	if err == model.ErrNotFound {
+		return nil, pb.NewError(pb.ErrorCode_ERR_USER_NOT_FOUND, "user not found")
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, pb.NewError(pb.ErrorCode_ERR_INTERNAL, "server error")
 	}
 
 	return &pb.User{
@@ -22,5 +26,82 @@ func (s *GameServiceServer) GetUserInfo(ctx context.Context, arg *pb.String) (*p
 		Email:     usr.GetEmail(),
 		CreatedAt: usr.GetCreatedAt(),
 		UpdatedAt: usr.GetUpdatedAt(),
+		Profile:   userProfile(usr),
 	}, nil
 }
+
+// Public profile of any user, no email
+func (s *GameServiceServer) GetProfile(ctx context.Context, arg *pb.String) (*pb.Profile, error) {
+	usr, err := model.GetUserById(arg.GetValue())
+	if err == model.ErrNotFound {
+		return nil, pb.NewError(pb.ErrorCode_ERR_USER_NOT_FOUND, "user not found")
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, pb.NewError(pb.ErrorCode_ERR_INTERNAL, "server error")
+	}
+
+	return userProfile(usr), nil
+}
+
+// Replace editable fields, saved to redis if user online else mgo
+func (s *GameServiceServer) UpdateProfile(ctx context.Context, arg *pb.UpdateProfileArg) (*pb.Profile, error) {
+	nickname := strings.TrimSpace(arg.GetNickname())
+	if err := ValidateNickname(nickname); err != nil {
+		return nil, err
+	}
+	if err := ValidateAvatar(int(arg.GetAvatar())); err != nil {
+		return nil, err
+	}
+	if err := ValidateBio(arg.GetBio()); err != nil {
+		return nil, err
+	}
+
+	usr, err := model.GetUserById(arg.GetUid())
+	if err == model.ErrNotFound {
+		return nil, pb.NewError(pb.ErrorCode_ERR_USER_NOT_FOUND, "user not found")
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, pb.NewError(pb.ErrorCode_ERR_INTERNAL, "server error")
+	}
+
+	usr.SetNickname(nickname)
+	usr.SetAvatar(int(arg.GetAvatar()))
+	usr.SetBio(arg.GetBio())
+	if err := usr.Set(); err != nil {
+		log.Println(err)
+		return nil, pb.NewError(pb.ErrorCode_ERR_INTERNAL, "server error")
+	}
+
+	return userProfile(usr), nil
+}
+
+func userProfile(usr *model.User) *pb.Profile {
+	return &pb.Profile{
+		Uid:      usr.GetId(),
+		Name:     usr.GetName(),
+		Nickname: usr.GetNickname(),
+		Avatar:   int32(usr.GetAvatar()),
+		Bio:      usr.GetBio(),
+		Level:    int32(usr.GetLevel()),
+		Rating:   int32(usr.GetRating()),
+	}
+}
+
+func (s *GameServiceServer) PushSystem(ctx context.Context, arg *pb.PushSystemArg) (*pb.Empty, error) {
+	msg := pb.MakePush_SystemPush(arg.GetPush())
+
+	var err error
+	if uid := arg.GetUid(); uid != "" {
+		err = PushUser(uid, msg)
+	} else {
+		err = PushAll(msg)
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, pb.NewError(pb.ErrorCode_ERR_INTERNAL, "server error")
+	}
+
+	return &pb.Empty{}, nil
+}
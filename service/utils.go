@@ -0,0 +1,47 @@
+package main
+
+import (
+	"game_server/pb"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	maxAvatar = 99
+	maxBioLen = 140
+)
+
+// Empty as default name, else 2-16 chars without control chars
+func ValidateNickname(nickname string) error {
+	if nickname == "" {
+		return nil
+	}
+
+	if n := utf8.RuneCountInString(nickname); n < 2 || n > 16 {
+		return pb.NewError(pb.ErrorCode_ERR_NICKNAME_INVALID, "nickname length between 2-16")
+	}
+
+	for _, r := range nickname {
+		if unicode.IsControl(r) || r == utf8.RuneError {
+			return pb.NewError(pb.ErrorCode_ERR_NICKNAME_INVALID, "nickname invalid")
+		}
+	}
+
+	return nil
+}
+
+func ValidateAvatar(avatar int) error {
+	if avatar < 0 || avatar > maxAvatar {
+		return pb.NewError(pb.ErrorCode_ERR_AVATAR_INVALID, "avatar invalid")
+	}
+
+	return nil
+}
+
+func ValidateBio(bio string) error {
+	if !utf8.ValidString(bio) || utf8.RuneCountInString(bio) > maxBioLen {
+		return pb.NewError(pb.ErrorCode_ERR_BIO_INVALID, "bio max 140 chars")
+	}
+
+	return nil
+}